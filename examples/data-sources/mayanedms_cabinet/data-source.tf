@@ -0,0 +1,3 @@
+data "mayanedms_cabinet" "invoices_2026" {
+  full_path = "Finance/Invoices/2026"
+}
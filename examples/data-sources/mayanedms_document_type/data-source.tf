@@ -0,0 +1,3 @@
+data "mayanedms_document_type" "invoice" {
+  label = "Invoice"
+}
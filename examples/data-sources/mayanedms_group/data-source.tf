@@ -0,0 +1,3 @@
+data "mayanedms_group" "finance" {
+  name = "Finance"
+}
@@ -0,0 +1,3 @@
+data "mayanedms_index_template" "bills" {
+  slug = "bills"
+}
@@ -0,0 +1,3 @@
+data "mayanedms_metadata_type" "company" {
+  name = "company"
+}
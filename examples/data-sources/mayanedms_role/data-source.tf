@@ -0,0 +1,3 @@
+data "mayanedms_role" "automated" {
+  label = "Automated"
+}
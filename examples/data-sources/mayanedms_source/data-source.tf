@@ -0,0 +1,3 @@
+data "mayanedms_source" "scanner" {
+  label = "Scanner"
+}
@@ -0,0 +1,3 @@
+data "mayanedms_tag" "bill" {
+  label = "Bill"
+}
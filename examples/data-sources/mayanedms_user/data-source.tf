@@ -0,0 +1,3 @@
+data "mayanedms_user" "admin" {
+  username = "admin"
+}
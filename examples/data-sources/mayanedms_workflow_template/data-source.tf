@@ -0,0 +1,3 @@
+data "mayanedms_workflow_template" "approval" {
+  internal_name = "approval"
+}
@@ -0,0 +1,8 @@
+data "mayanedms_workflow_template_graph" "invoice_approval" {
+  workflow_template = mayanedms_workflow.invoice_approval.id
+}
+
+resource "local_file" "invoice_approval_diagram" {
+  filename = "${path.module}/invoice_approval.dot"
+  content  = data.mayanedms_workflow_template_graph.invoice_approval.dot
+}
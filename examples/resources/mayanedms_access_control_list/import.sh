@@ -0,0 +1,2 @@
+# import an access control list as <content_type>-<object_id>-<role_id>
+terraform import "mayanedms_access_control_list.finance_invoices" "documents.documenttype-4-2"
@@ -0,0 +1,11 @@
+resource "mayanedms_access_control_list" "finance_invoices" {
+  content_type = "documents.documenttype"
+  object_id    = mayanedms_document_type.invoice.id
+  role         = mayanedms_role.finance.id
+  permissions = [
+    "documents.document_view",
+    "documents.document_edit",
+    "documents.document_properties_edit",
+    "metadata.metadata_document_view",
+  ]
+}
@@ -0,0 +1,2 @@
+# import a cabinet by id
+terraform import "mayanedms_cabinet.finance_invoices" "4"
@@ -0,0 +1,8 @@
+resource "mayanedms_cabinet" "finance" {
+  label = "Finance"
+}
+
+resource "mayanedms_cabinet" "finance_invoices" {
+  label     = "Invoices"
+  parent_id = mayanedms_cabinet.finance.id
+}
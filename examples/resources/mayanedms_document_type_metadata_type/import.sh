@@ -0,0 +1,2 @@
+# import a metadata type of a document type, by document type id and the id of the binding
+terraform import "mayanedms_document_type_metadata_type.pdf_company" "3-7"
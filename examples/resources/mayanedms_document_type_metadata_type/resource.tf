@@ -0,0 +1,10 @@
+resource "mayanedms_document_type_metadata_type" "pdf_company" {
+  document_type_id = mayanedms_document_type.pdf.id
+  metadata_type_id = mayanedms_metadata_type.company.id
+  required         = true
+}
+
+resource "mayanedms_document_type_metadata_type" "pdf_year" {
+  document_type_id = mayanedms_document_type.pdf.id
+  metadata_type_id = mayanedms_metadata_type.year.id
+}
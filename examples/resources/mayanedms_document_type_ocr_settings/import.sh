@@ -0,0 +1,2 @@
+# import the OCR and parsing settings of a document type by the document type id
+terraform import "mayanedms_document_type_ocr_settings.scan" "3"
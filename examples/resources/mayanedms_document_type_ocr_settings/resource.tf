@@ -0,0 +1,12 @@
+# Scans need OCR, born-digital PDFs already carry their text
+resource "mayanedms_document_type_ocr_settings" "scan" {
+  document_type_id = mayanedms_document_type.scan.id
+  auto_ocr         = true
+  auto_parsing     = false
+}
+
+resource "mayanedms_document_type_ocr_settings" "pdf" {
+  document_type_id = mayanedms_document_type.pdf.id
+  auto_ocr         = false
+  auto_parsing     = true
+}
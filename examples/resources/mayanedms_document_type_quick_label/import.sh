@@ -0,0 +1,2 @@
+# import a quick label of a document type, by document type id and quick label id
+terraform import "mayanedms_document_type_quick_label.pdf_invoice" "3-2"
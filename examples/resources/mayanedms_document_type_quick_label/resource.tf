@@ -0,0 +1,15 @@
+resource "mayanedms_document_type_quick_label" "pdf_invoice" {
+  document_type_id = mayanedms_document_type.pdf.id
+  label            = "Invoice"
+}
+
+resource "mayanedms_document_type_quick_label" "pdf_receipt" {
+  document_type_id = mayanedms_document_type.pdf.id
+  label            = "Receipt"
+}
+
+resource "mayanedms_document_type_quick_label" "pdf_statement" {
+  document_type_id = mayanedms_document_type.pdf.id
+  label            = "Statement"
+  enabled          = false
+}
@@ -0,0 +1,2 @@
+# import an IMAP email source by id
+terraform import "mayanedms_imap_email_source.invoices" "5"
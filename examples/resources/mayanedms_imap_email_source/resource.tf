@@ -0,0 +1,15 @@
+resource "mayanedms_imap_email_source" "invoices" {
+  label                    = "Invoices mailbox"
+  host                     = "imap.example.com"
+  username                 = "invoices@example.com"
+  password                 = var.invoices_mailbox_password
+  mailbox                  = "INBOX"
+  search_criteria          = "UNSEEN"
+  store_commands           = "+FLAGS (\\Seen)"
+  execute_expunge          = false
+  document_type_id         = mayanedms_document_type.email.id
+  subject_metadata_type_id = mayanedms_metadata_type.subject.id
+  from_metadata_type_id    = mayanedms_metadata_type.sender.id
+  store_body               = false
+  interval                 = 600
+}
@@ -0,0 +1,7 @@
+resource "mayanedms_index_rebuild" "invoices" {
+  index_template = mayanedms_index_template.invoices.id
+
+  triggers = {
+    nodes = jsonencode(mayanedms_index_template_tree.invoices.node)
+  }
+}
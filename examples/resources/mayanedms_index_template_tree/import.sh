@@ -0,0 +1,2 @@
+# import the nodes of an index template by the index template id
+terraform import "mayanedms_index_template_tree.invoices" "8"
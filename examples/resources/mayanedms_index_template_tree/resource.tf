@@ -0,0 +1,25 @@
+resource "mayanedms_index_template" "invoices" {
+  label = "Invoices"
+  slug  = "invoices"
+  document_types = [
+    mayanedms_document_type.pdf.id,
+  ]
+}
+
+resource "mayanedms_index_template_tree" "invoices" {
+  index_template    = mayanedms_index_template.invoices.id
+  rebuild_on_change = true
+
+  node {
+    key            = "company"
+    expression     = "{{ document.metadata_value_of.company }}"
+    link_documents = false
+  }
+
+  node {
+    key            = "year"
+    parent         = "company"
+    expression     = "{{ document.datetime_created|date:\"Y\" }}"
+    link_documents = true
+  }
+}
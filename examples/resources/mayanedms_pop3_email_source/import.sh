@@ -0,0 +1,2 @@
+# import a POP3 email source by id
+terraform import "mayanedms_pop3_email_source.scans" "6"
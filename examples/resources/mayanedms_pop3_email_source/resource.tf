@@ -0,0 +1,10 @@
+resource "mayanedms_pop3_email_source" "scans" {
+  label            = "Scanner mailbox"
+  host             = "pop.example.com"
+  username         = "scanner@example.com"
+  password         = var.scanner_mailbox_password
+  document_type_id = mayanedms_document_type.scan.id
+  uncompress       = "yes"
+  store_body       = false
+  interval         = 600
+}
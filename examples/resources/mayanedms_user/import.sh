@@ -0,0 +1,2 @@
+# import a user
+terraform import "mayanedms_user.scanner" "3"
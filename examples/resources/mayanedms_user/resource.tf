@@ -0,0 +1,12 @@
+resource "mayanedms_user" "scanner" {
+  username   = "scanner"
+  first_name = "Office"
+  last_name  = "Scanner"
+  email      = "scanner@example.com"
+  password   = var.scanner_password
+}
+
+resource "mayanedms_group" "automated" {
+  name  = "Automated"
+  users = [mayanedms_user.scanner.id]
+}
@@ -0,0 +1,2 @@
+# import an existing workflow together with its states and transitions
+terraform import "mayanedms_workflow.invoice_approval" "8"
\ No newline at end of file
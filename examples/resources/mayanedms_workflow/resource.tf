@@ -0,0 +1,41 @@
+resource "mayanedms_workflow" "invoice_approval" {
+  label         = "Invoice Approval"
+  internal_name = "invoice_approval"
+  document_types = [
+    mayanedms_document_type.invoice.id,
+  ]
+
+  state {
+    label   = "Received"
+    initial = true
+  }
+
+  state {
+    label      = "Approved"
+    completion = 50
+  }
+
+  state {
+    label      = "Paid"
+    completion = 100
+  }
+
+  transition {
+    label             = "Approve"
+    origin_state      = "Received"
+    destination_state = "Approved"
+  }
+
+  transition {
+    label             = "Pay"
+    origin_state      = "Approved"
+    destination_state = "Paid"
+    condition         = "{{ document.metadata_value_of.amount }}"
+  }
+}
+
+resource "mayanedms_workflow_template_state_escalation" "invoice_approval_reminder" {
+  state      = mayanedms_workflow.invoice_approval.state_ids["Received"]
+  transition = mayanedms_workflow.invoice_approval.transition_ids["Approve"]
+  amount     = 14
+}
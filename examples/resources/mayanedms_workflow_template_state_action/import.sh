@@ -0,0 +1,2 @@
+# import an existing action of a state of the workflow
+terraform import "mayanedms_workflow_template_state_action.auto_processing_tag_new" "8-3-5"
\ No newline at end of file
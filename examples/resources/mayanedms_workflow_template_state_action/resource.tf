@@ -0,0 +1,21 @@
+resource "mayanedms_workflow_template_state_action" "auto_processing_tag_new" {
+  label = "Tag as new"
+  when  = "on_entry"
+  state = mayanedms_workflow_template_state.auto_processing_new.id
+
+  attach_tag {
+    tags = [mayanedms_tag.new.id]
+  }
+}
+
+resource "mayanedms_workflow_template_state_action" "auto_processing_notify" {
+  label       = "Notify archive"
+  when        = "on_exit"
+  state       = mayanedms_workflow_template_state.auto_processing_ocr_finished.id
+  action_path = "mayan.apps.mailer.workflow_actions.EmailAction"
+  action_data = jsonencode({
+    mailing_profile = 1
+    recipient       = "archive@example.com"
+    subject         = "{{ document.label }} processed"
+  })
+}
@@ -0,0 +1,2 @@
+# import an existing escalation of a state of the workflow
+terraform import "mayanedms_workflow_template_state_escalation.auto_processing_stuck" "8-3-1"
\ No newline at end of file
@@ -0,0 +1,7 @@
+resource "mayanedms_workflow_template_state_escalation" "auto_processing_stuck" {
+  state      = mayanedms_workflow_template_state.auto_processing_new.id
+  transition = mayanedms_workflow_template_transition.auto_processing_new.id
+  amount     = 2
+  unit       = "days"
+  comment    = "OCR did not finish within two days"
+}
@@ -0,0 +1,2 @@
+# import an existing field of a transition of the workflow
+terraform import "mayanedms_workflow_template_transition_field.auto_processing_reviewer_note" "8-12-4"
\ No newline at end of file
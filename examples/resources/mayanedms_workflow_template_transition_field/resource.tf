@@ -0,0 +1,8 @@
+resource "mayanedms_workflow_template_transition_field" "auto_processing_reviewer_note" {
+  transition = mayanedms_workflow_template_transition.auto_processing_new.id
+  name       = "reviewer_note"
+  label      = "Reviewer note"
+  field_type = "character"
+  widget     = "textarea"
+  required   = false
+}
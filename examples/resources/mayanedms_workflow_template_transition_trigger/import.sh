@@ -0,0 +1,2 @@
+# import an existing trigger of a transition of the workflow
+terraform import "mayanedms_workflow_template_transition_trigger.auto_processing_ocr_finished" "8-12-2"
\ No newline at end of file
@@ -0,0 +1,4 @@
+resource "mayanedms_workflow_template_transition_trigger" "auto_processing_ocr_finished" {
+  transition = mayanedms_workflow_template_transition.auto_processing_new.id
+  event_type = "ocr.document_version_ocr_finished"
+}
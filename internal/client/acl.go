@@ -0,0 +1,147 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// AccessControlList grants a role permissions on a single object. The object
+// is identified by its content type, in the "app_label.model" form such as
+// "documents.documenttype", and its id.
+type AccessControlList struct {
+	ID          int
+	ContentType string
+	ObjectID    int
+	RoleID      int
+}
+
+type accessControlListResponse struct {
+	ID   int `json:"id"`
+	Role struct {
+		ID int `json:"id"`
+	} `json:"role"`
+}
+
+func aclPath(contentType string, objectId int) string {
+	return fmt.Sprintf("objects/%v/%v/acls/", strings.Replace(contentType, ".", "/", 1), objectId)
+}
+
+func (r accessControlListResponse) toAccessControlList(contentType string, objectId int) *AccessControlList {
+	return &AccessControlList{
+		ID:          r.ID,
+		ContentType: contentType,
+		ObjectID:    objectId,
+		RoleID:      r.Role.ID,
+	}
+}
+
+func (c *Client) CreateAccessControlList(ctx context.Context, acl AccessControlList) (*AccessControlList, error) {
+	request := struct {
+		RoleId int `json:"role_id"`
+	}{
+		RoleId: acl.RoleID,
+	}
+
+	var created accessControlListResponse
+	err := c.performRequest(ctx, aclPath(acl.ContentType, acl.ObjectID), http.MethodPost, &request, &created)
+	if err != nil {
+		return &AccessControlList{}, err
+	}
+
+	return created.toAccessControlList(acl.ContentType, acl.ObjectID), nil
+}
+
+// GetAccessControlListByRole finds the access control list of a role on an
+// object. Mayan EDMS keeps at most one per role and object; a role without
+// one is reported as not found.
+func (c *Client) GetAccessControlListByRole(ctx context.Context, contentType string, objectId int, roleId int) (*AccessControlList, error) {
+	var found *AccessControlList
+	path := aclPath(contentType, objectId)
+	err := c.listAll(ctx, path, func(results json.RawMessage) error {
+		var page []accessControlListResponse
+		if err := json.Unmarshal(results, &page); err != nil {
+			return err
+		}
+
+		for _, acl := range page {
+			if acl.Role.ID == roleId {
+				found = acl.toAccessControlList(contentType, objectId)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return &AccessControlList{}, err
+	}
+
+	if found == nil {
+		return &AccessControlList{}, &APIError{
+			StatusCode: http.StatusNotFound,
+			Method:     http.MethodGet,
+			Path:       path,
+			Detail:     fmt.Sprintf("no access control list for role %v", roleId),
+		}
+	}
+
+	return found, nil
+}
+
+func (c *Client) DeleteAccessControlList(ctx context.Context, contentType string, objectId int, aclId int) error {
+	err := c.performRequest(ctx, fmt.Sprintf("%v%v/", aclPath(contentType, objectId), aclId), http.MethodDelete, nil, nil)
+	return err
+}
+
+func (c *Client) GetAccessControlListPermissions(ctx context.Context, contentType string, objectId int, aclId int) ([]string, error) {
+	var ids []string
+	err := c.listAll(ctx, fmt.Sprintf("%v%v/permissions/", aclPath(contentType, objectId), aclId), func(results json.RawMessage) error {
+		var permissions []struct {
+			Pk string `json:"pk"`
+		}
+		if err := json.Unmarshal(results, &permissions); err != nil {
+			return err
+		}
+
+		for _, permission := range permissions {
+			ids = append(ids, permission.Pk)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+func (c *Client) AddAccessControlListPermission(ctx context.Context, contentType string, objectId int, aclId int, permissionPk string) error {
+
+	var request struct {
+		Permission string `json:"permission"`
+	}
+	request.Permission = permissionPk
+
+	err := c.performRequest(ctx, fmt.Sprintf("%v%v/permissions/add/", aclPath(contentType, objectId), aclId), http.MethodPost, &request, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) RemoveAccessControlListPermission(ctx context.Context, contentType string, objectId int, aclId int, permissionPk string) error {
+
+	var request struct {
+		Permission string `json:"permission"`
+	}
+	request.Permission = permissionPk
+
+	err := c.performRequest(ctx, fmt.Sprintf("%v%v/permissions/remove/", aclPath(contentType, objectId), aclId), http.MethodPost, &request, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type Cabinet struct {
+	ID       int    `json:"id"`
+	Label    string `json:"label"`
+	Parent   *int   `json:"parent"`
+	FullPath string `json:"full_path,omitempty"`
+}
+
+func (c *Client) CreateCabinet(ctx context.Context, cabinet Cabinet) (*Cabinet, error) {
+	var createdCabinet *Cabinet
+	err := c.performRequest(ctx, "cabinets/", http.MethodPost, &cabinet, &createdCabinet)
+	if err != nil {
+		return &Cabinet{}, err
+	}
+
+	return createdCabinet, nil
+}
+
+func (c *Client) GetCabinetById(ctx context.Context, id int) (*Cabinet, error) {
+	var cabinet *Cabinet
+	err := c.performRequest(ctx, fmt.Sprintf("cabinets/%v/", id), http.MethodGet, nil, &cabinet)
+	if err != nil {
+		return &Cabinet{}, err
+	}
+
+	return cabinet, nil
+}
+
+func (c *Client) GetCabinets(ctx context.Context) ([]Cabinet, error) {
+	var cabinets []Cabinet
+	err := c.listAll(ctx, "cabinets/", func(results json.RawMessage) error {
+		var page []Cabinet
+		if err := json.Unmarshal(results, &page); err != nil {
+			return err
+		}
+
+		cabinets = append(cabinets, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return cabinets, nil
+}
+
+func (c *Client) DeleteCabinet(ctx context.Context, id int) error {
+	err := c.performRequest(ctx, fmt.Sprintf("cabinets/%v/", id), http.MethodDelete, nil, nil)
+	return err
+}
+
+func (c *Client) UpdateCabinet(ctx context.Context, cabinet Cabinet) (*Cabinet, error) {
+	var updatedCabinet *Cabinet
+	err := c.performRequest(ctx, fmt.Sprintf("cabinets/%v/", cabinet.ID), http.MethodPut, &cabinet, &updatedCabinet)
+	if err != nil {
+		return &Cabinet{}, err
+	}
+
+	return updatedCabinet, nil
+}
@@ -2,103 +2,221 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
+	"log"
 	"net/http"
+	"time"
 )
 
 type MayanEdmsClient interface {
-	GetDocumentTypeById(id int) (*DocumentType, error)
-	CreateDocumentType(documentType DocumentType) (*DocumentType, error)
-	UpdateDocumentType(documentType DocumentType) (*DocumentType, error)
-	DeleteDocumentType(id int) error
-
-	GetSourceById(id int) (*Source, error)
-	CreateSource(source Source) (*Source, error)
-	UpdateSource(documentType Source) (*Source, error)
-	DeleteSource(id int) error
-
-	GetTagById(id int) (*Tag, error)
-	CreateTag(tag Tag) (*Tag, error)
-	UpdateTag(tag Tag) (*Tag, error)
-	DeleteTag(id int) error
-
-	GetIndexTemplateById(id int) (*IndexTemplate, error)
-	CreateIndexTemplate(indexTemplate IndexTemplate) (*IndexTemplate, error)
-	UpdateIndexTemplate(indexTemplate IndexTemplate) (*IndexTemplate, error)
-	DeleteIndexTemplate(id int) error
-
-	GetIndexTemplateDocumentTypes(indexTemplateId int) ([]int, error)
-	AddIndexTemplateDocumentType(indexTemplateId int, documentTypeId int) error
-	RemoveIndexTemplateDocumentType(indexTemplateId int, documentTypeId int) error
-
-	GetIndexTemplateNodeById(indexId, nodeId int) (*IndexTemplateNode, error)
-	CreateIndexTemplateNode(indexTemplateNode IndexTemplateNode) (*IndexTemplateNode, error)
-	UpdateIndexTemplateNode(indexTemplateId int, indexTemplateNode IndexTemplateNode) (*IndexTemplateNode, error)
-	DeleteIndexTemplateNode(indexId, nodeId int) error
-
-	GetGroupById(id int) (*Group, error)
-	CreateGroup(group Group) (*Group, error)
-	UpdateGroup(group Group) (*Group, error)
-	DeleteGroup(id int) error
-	GetGroupUsers(groupId int) ([]int, error)
-	AddGroupUser(groupId int, userId int) error
-	RemoveGroupUser(groupId int, userId int) error
-
-	GetWorkflowTemplateById(id int) (*WorkflowTemplate, error)
-	CreateWorkflowTemplate(workflowTemplate WorkflowTemplate) (*WorkflowTemplate, error)
-	UpdateWorkflowTemplate(workflowTemplate WorkflowTemplate) (*WorkflowTemplate, error)
-	DeleteWorkflowTemplate(id int) error
-	GetWorkflowIndexDocumentTypes(workflowTemplateId int) ([]int, error)
-	AddWorkflowIndexDocumentType(workflowTemplateId int, documentTypeId int) error
-	RemoveWorkflowIndexDocumentType(workflowTemplateId int, documentTypeId int) error
-
-	GetWorkflowTemplateState(workflowTemplateId int, stateId int) (*WorkflowTemplateState, error)
-	CreateWorkflowTemplateState(workflowTemplateId int, state WorkflowTemplateState) (*WorkflowTemplateState, error)
-	RemoveWorkflowTemplateState(workflowTemplateId int, stateId int) error
-	UpdateWorkflowTemplateState(workflowTemplateId int, state WorkflowTemplateState) (*WorkflowTemplateState, error)
-
-	GetWorkflowTemplateTransition(workflowTemplateId int, transitionId int) (*WorkflowTemplateTransition, error)
-	CreateWorkflowTemplateTransition(workflowTemplateId int, transition WorkflowTemplateTransition) (*WorkflowTemplateTransition, error)
-	RemoveWorkflowTemplateTransition(workflowTemplateId int, transitionId int) error
-	UpdateWorkflowTemplateTransition(workflowTemplateId int, transition WorkflowTemplateTransition) (*WorkflowTemplateTransition, error)
-
-	GetRoleById(id int) (*Role, error)
-	CreateRole(tag Role) (*Role, error)
-	UpdateRole(tag Role) (*Role, error)
-	DeleteRole(id int) error
-	GetRoleGroups(roleId int) ([]int, error)
-	AddRoleGroup(roleId int, groupId int) error
-	RemoveRoleGroup(roleId int, groupId int) error
-	GetRolePermissions(roleId int) ([]string, error)
-	AddRolePermission(roleId int, permissionPk string) error
-	RemoveRolePermission(roleId int, permissionPk string) error
-
-	CreateMetadataType(metadataType MetadataType) (*MetadataType, error)
-	GetMetadataTypeById(id int) (*MetadataType, error)
-	DeleteMetadataType(id int) error
-	UpdateMetadataType(metadataType MetadataType) (*MetadataType, error)
+	GetDocumentTypeById(ctx context.Context, id int) (*DocumentType, error)
+	GetDocumentTypes(ctx context.Context) ([]DocumentType, error)
+	CreateDocumentType(ctx context.Context, documentType DocumentType) (*DocumentType, error)
+	UpdateDocumentType(ctx context.Context, documentType DocumentType) (*DocumentType, error)
+	DeleteDocumentType(ctx context.Context, id int) error
+
+	GetDocumentTypeMetadataType(ctx context.Context, documentTypeId int, id int) (*DocumentTypeMetadataType, error)
+	CreateDocumentTypeMetadataType(ctx context.Context, documentTypeId int, metadataType DocumentTypeMetadataType) (*DocumentTypeMetadataType, error)
+	UpdateDocumentTypeMetadataType(ctx context.Context, documentTypeId int, metadataType DocumentTypeMetadataType) (*DocumentTypeMetadataType, error)
+	RemoveDocumentTypeMetadataType(ctx context.Context, documentTypeId int, id int) error
+
+	GetDocumentTypeQuickLabel(ctx context.Context, documentTypeId int, id int) (*DocumentTypeQuickLabel, error)
+	CreateDocumentTypeQuickLabel(ctx context.Context, documentTypeId int, quickLabel DocumentTypeQuickLabel) (*DocumentTypeQuickLabel, error)
+	UpdateDocumentTypeQuickLabel(ctx context.Context, documentTypeId int, quickLabel DocumentTypeQuickLabel) (*DocumentTypeQuickLabel, error)
+	RemoveDocumentTypeQuickLabel(ctx context.Context, documentTypeId int, id int) error
+
+	GetDocumentTypeOCRSettings(ctx context.Context, documentTypeId int) (*DocumentTypeOCRSettings, error)
+	UpdateDocumentTypeOCRSettings(ctx context.Context, documentTypeId int, settings DocumentTypeOCRSettings) (*DocumentTypeOCRSettings, error)
+	GetDocumentTypeParsingSettings(ctx context.Context, documentTypeId int) (*DocumentTypeParsingSettings, error)
+	UpdateDocumentTypeParsingSettings(ctx context.Context, documentTypeId int, settings DocumentTypeParsingSettings) (*DocumentTypeParsingSettings, error)
+
+	GetSourceById(ctx context.Context, id int) (*Source, error)
+	GetSources(ctx context.Context) ([]Source, error)
+	CreateSource(ctx context.Context, source Source) (*Source, error)
+	UpdateSource(ctx context.Context, documentType Source) (*Source, error)
+	DeleteSource(ctx context.Context, id int) error
+
+	GetTagById(ctx context.Context, id int) (*Tag, error)
+	GetTags(ctx context.Context) ([]Tag, error)
+	CreateTag(ctx context.Context, tag Tag) (*Tag, error)
+	UpdateTag(ctx context.Context, tag Tag) (*Tag, error)
+	DeleteTag(ctx context.Context, id int) error
+
+	GetCabinetById(ctx context.Context, id int) (*Cabinet, error)
+	GetCabinets(ctx context.Context) ([]Cabinet, error)
+	CreateCabinet(ctx context.Context, cabinet Cabinet) (*Cabinet, error)
+	UpdateCabinet(ctx context.Context, cabinet Cabinet) (*Cabinet, error)
+	DeleteCabinet(ctx context.Context, id int) error
+
+	GetIndexTemplateById(ctx context.Context, id int) (*IndexTemplate, error)
+	GetIndexTemplates(ctx context.Context) ([]IndexTemplate, error)
+	CreateIndexTemplate(ctx context.Context, indexTemplate IndexTemplate) (*IndexTemplate, error)
+	UpdateIndexTemplate(ctx context.Context, indexTemplate IndexTemplate) (*IndexTemplate, error)
+	DeleteIndexTemplate(ctx context.Context, id int) error
+	RebuildIndexTemplate(ctx context.Context, id int) error
+
+	GetIndexTemplateDocumentTypes(ctx context.Context, indexTemplateId int) ([]int, error)
+	AddIndexTemplateDocumentType(ctx context.Context, indexTemplateId int, documentTypeId int) error
+	RemoveIndexTemplateDocumentType(ctx context.Context, indexTemplateId int, documentTypeId int) error
+
+	GetIndexTemplateNodeById(ctx context.Context, indexId, nodeId int) (*IndexTemplateNode, error)
+	GetIndexTemplateNodes(ctx context.Context, indexId int) ([]IndexTemplateNode, error)
+	CreateIndexTemplateNode(ctx context.Context, indexTemplateNode IndexTemplateNode) (*IndexTemplateNode, error)
+	UpdateIndexTemplateNode(ctx context.Context, indexTemplateId int, indexTemplateNode IndexTemplateNode) (*IndexTemplateNode, error)
+	DeleteIndexTemplateNode(ctx context.Context, indexId, nodeId int) error
+
+	GetGroupById(ctx context.Context, id int) (*Group, error)
+	GetGroups(ctx context.Context) ([]Group, error)
+	CreateGroup(ctx context.Context, group Group) (*Group, error)
+	UpdateGroup(ctx context.Context, group Group) (*Group, error)
+	DeleteGroup(ctx context.Context, id int) error
+	GetGroupUsers(ctx context.Context, groupId int) ([]int, error)
+	AddGroupUser(ctx context.Context, groupId int, userId int) error
+	RemoveGroupUser(ctx context.Context, groupId int, userId int) error
+
+	GetUserById(ctx context.Context, id int) (*User, error)
+	GetUsers(ctx context.Context) ([]User, error)
+	CreateUser(ctx context.Context, user User) (*User, error)
+	UpdateUser(ctx context.Context, user User) (*User, error)
+	DeleteUser(ctx context.Context, id int) error
+
+	GetWorkflowTemplateById(ctx context.Context, id int) (*WorkflowTemplate, error)
+	GetWorkflowTemplates(ctx context.Context) ([]WorkflowTemplate, error)
+	CreateWorkflowTemplate(ctx context.Context, workflowTemplate WorkflowTemplate) (*WorkflowTemplate, error)
+	UpdateWorkflowTemplate(ctx context.Context, workflowTemplate WorkflowTemplate) (*WorkflowTemplate, error)
+	DeleteWorkflowTemplate(ctx context.Context, id int) error
+	GetWorkflowIndexDocumentTypes(ctx context.Context, workflowTemplateId int) ([]int, error)
+	AddWorkflowIndexDocumentType(ctx context.Context, workflowTemplateId int, documentTypeId int) error
+	RemoveWorkflowIndexDocumentType(ctx context.Context, workflowTemplateId int, documentTypeId int) error
+
+	GetWorkflowTemplateState(ctx context.Context, workflowTemplateId int, stateId int) (*WorkflowTemplateState, error)
+	GetWorkflowTemplateStates(ctx context.Context, workflowTemplateId int) ([]WorkflowTemplateState, error)
+	CreateWorkflowTemplateState(ctx context.Context, workflowTemplateId int, state WorkflowTemplateState) (*WorkflowTemplateState, error)
+	RemoveWorkflowTemplateState(ctx context.Context, workflowTemplateId int, stateId int) error
+	UpdateWorkflowTemplateState(ctx context.Context, workflowTemplateId int, state WorkflowTemplateState) (*WorkflowTemplateState, error)
+
+	GetWorkflowTemplateStateAction(ctx context.Context, workflowTemplateId int, stateId int, actionId int) (*WorkflowStateAction, error)
+	CreateWorkflowTemplateStateAction(ctx context.Context, workflowTemplateId int, stateId int, action WorkflowStateAction) (*WorkflowStateAction, error)
+	RemoveWorkflowTemplateStateAction(ctx context.Context, workflowTemplateId int, stateId int, actionId int) error
+	UpdateWorkflowTemplateStateAction(ctx context.Context, workflowTemplateId int, stateId int, action WorkflowStateAction) (*WorkflowStateAction, error)
+
+	GetWorkflowTemplateStateEscalation(ctx context.Context, workflowTemplateId int, stateId int, escalationId int) (*WorkflowStateEscalation, error)
+	CreateWorkflowTemplateStateEscalation(ctx context.Context, workflowTemplateId int, stateId int, escalation WorkflowStateEscalation) (*WorkflowStateEscalation, error)
+	RemoveWorkflowTemplateStateEscalation(ctx context.Context, workflowTemplateId int, stateId int, escalationId int) error
+	UpdateWorkflowTemplateStateEscalation(ctx context.Context, workflowTemplateId int, stateId int, escalation WorkflowStateEscalation) (*WorkflowStateEscalation, error)
+
+	GetWorkflowTemplateTransition(ctx context.Context, workflowTemplateId int, transitionId int) (*WorkflowTemplateTransition, error)
+	GetWorkflowTemplateTransitions(ctx context.Context, workflowTemplateId int) ([]WorkflowTemplateTransition, error)
+	CreateWorkflowTemplateTransition(ctx context.Context, workflowTemplateId int, transition WorkflowTemplateTransition) (*WorkflowTemplateTransition, error)
+	RemoveWorkflowTemplateTransition(ctx context.Context, workflowTemplateId int, transitionId int) error
+	UpdateWorkflowTemplateTransition(ctx context.Context, workflowTemplateId int, transition WorkflowTemplateTransition) (*WorkflowTemplateTransition, error)
+
+	GetWorkflowTemplateTransitionTrigger(ctx context.Context, workflowTemplateId int, transitionId int, triggerId int) (*WorkflowTransitionTrigger, error)
+	CreateWorkflowTemplateTransitionTrigger(ctx context.Context, workflowTemplateId int, transitionId int, trigger WorkflowTransitionTrigger) (*WorkflowTransitionTrigger, error)
+	RemoveWorkflowTemplateTransitionTrigger(ctx context.Context, workflowTemplateId int, transitionId int, triggerId int) error
+
+	GetWorkflowTemplateTransitionField(ctx context.Context, workflowTemplateId int, transitionId int, fieldId int) (*WorkflowTransitionField, error)
+	CreateWorkflowTemplateTransitionField(ctx context.Context, workflowTemplateId int, transitionId int, field WorkflowTransitionField) (*WorkflowTransitionField, error)
+	RemoveWorkflowTemplateTransitionField(ctx context.Context, workflowTemplateId int, transitionId int, fieldId int) error
+	UpdateWorkflowTemplateTransitionField(ctx context.Context, workflowTemplateId int, transitionId int, field WorkflowTransitionField) (*WorkflowTransitionField, error)
+
+	GetRoleById(ctx context.Context, id int) (*Role, error)
+	GetRoles(ctx context.Context) ([]Role, error)
+	CreateRole(ctx context.Context, tag Role) (*Role, error)
+	UpdateRole(ctx context.Context, tag Role) (*Role, error)
+	DeleteRole(ctx context.Context, id int) error
+	GetRoleGroups(ctx context.Context, roleId int) ([]int, error)
+	AddRoleGroup(ctx context.Context, roleId int, groupId int) error
+	RemoveRoleGroup(ctx context.Context, roleId int, groupId int) error
+	GetRolePermissions(ctx context.Context, roleId int) ([]string, error)
+	AddRolePermission(ctx context.Context, roleId int, permissionPk string) error
+	RemoveRolePermission(ctx context.Context, roleId int, permissionPk string) error
+
+	GetAccessControlListByRole(ctx context.Context, contentType string, objectId int, roleId int) (*AccessControlList, error)
+	CreateAccessControlList(ctx context.Context, acl AccessControlList) (*AccessControlList, error)
+	DeleteAccessControlList(ctx context.Context, contentType string, objectId int, aclId int) error
+	GetAccessControlListPermissions(ctx context.Context, contentType string, objectId int, aclId int) ([]string, error)
+	AddAccessControlListPermission(ctx context.Context, contentType string, objectId int, aclId int, permissionPk string) error
+	RemoveAccessControlListPermission(ctx context.Context, contentType string, objectId int, aclId int, permissionPk string) error
+
+	CreateMetadataType(ctx context.Context, metadataType MetadataType) (*MetadataType, error)
+	GetMetadataTypeById(ctx context.Context, id int) (*MetadataType, error)
+	GetMetadataTypes(ctx context.Context) ([]MetadataType, error)
+	DeleteMetadataType(ctx context.Context, id int) error
+	UpdateMetadataType(ctx context.Context, metadataType MetadataType) (*MetadataType, error)
 }
 
 type ClientConfig struct {
-	Url                string
-	Username           string
-	Password           string
+	Url      string
+	Username string
+	Password string
+	// Token is a pre-issued API token. When set the username and password
+	// are not exchanged for a token.
+	Token              string
 	InsecureSkipVerify bool
+	PageSize           int
+
+	// CACertFile and CACertPEM add a certificate authority bundle used to
+	// verify the server, from a file or as PEM content respectively.
+	CACertFile string
+	CACertPEM  string
+	// ClientCert and ClientKey enable mutual TLS. Each holds PEM content or
+	// the path of a PEM file.
+	ClientCert string
+	ClientKey  string
+	// MinTLSVersion is one of TLSVersions(), defaulting to 1.2.
+	MinTLSVersion string
+
+	// RequestTimeout bounds a single attempt of a request. Zero means no
+	// timeout other than the one carried by the request context.
+	RequestTimeout time.Duration
+
+	// MaxRetries is the number of times a failed request is retried.
+	MaxRetries int
+	// RetryMaxWait caps the delay between two attempts.
+	RetryMaxWait time.Duration
+	// RetryAllMethods also retries requests that are not idempotent.
+	RetryAllMethods bool
 }
 
 type Client struct {
-	client *http.Client
-	url    string
-	token  string
+	client   *http.Client
+	url      string
+	token    string
+	pageSize int
+	retry    RetryPolicy
 }
 
-func NewMayanEdmsClient(config ClientConfig) (MayanEdmsClient, error) {
+func NewMayanEdmsClient(ctx context.Context, config ClientConfig) (MayanEdmsClient, error) {
+	tlsConfig, err := newTLSConfig(config)
+	if err != nil {
+		return &Client{}, err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
 	client := &Client{
-		client: &http.Client{},
-		url:    config.Url + "/api/v4/",
+		client:   &http.Client{Transport: transport, Timeout: config.RequestTimeout},
+		url:      config.Url + "/api/v4/",
+		pageSize: config.PageSize,
+		retry: RetryPolicy{
+			MaxRetries:      config.MaxRetries,
+			MaxWait:         config.RetryMaxWait,
+			RetryAllMethods: config.RetryAllMethods,
+		}.withDefaults(),
+	}
+
+	if client.pageSize <= 0 {
+		client.pageSize = defaultPageSize
+	}
+
+	if config.Token != "" {
+		client.token = config.Token
+		return client, nil
 	}
 
 	request := struct {
@@ -113,7 +231,7 @@ func NewMayanEdmsClient(config ClientConfig) (MayanEdmsClient, error) {
 		Token string `json:"token"`
 	}{}
 
-	err := client.performRequest("auth/token/obtain/", http.MethodPost, &request, &response)
+	err = client.performRequest(ctx, "auth/token/obtain/", http.MethodPost, &request, &response)
 	if err != nil {
 		return &Client{}, fmt.Errorf("failed to obtain token: %v", err)
 	}
@@ -123,28 +241,54 @@ func NewMayanEdmsClient(config ClientConfig) (MayanEdmsClient, error) {
 	return client, nil
 }
 
-func (c *Client) performRequest(path string, method string, body interface{}, response interface{}) error {
-
-	var req *http.Request
-	var err error
+func (c *Client) performRequest(ctx context.Context, path string, method string, body interface{}, response interface{}) error {
+	var jsonData []byte
 	if body != nil {
-		json_data, err := json.Marshal(body)
-
+		var err error
+		jsonData, err = json.Marshal(body)
 		if err != nil {
 			return err
 		}
+	}
 
-		req, err = http.NewRequest(method, c.url+path, bytes.NewBuffer(json_data))
+	for attempt := 0; ; attempt++ {
+		resp, err := c.doRequest(ctx, path, method, jsonData)
+		if ctx.Err() != nil || !c.retry.shouldRetry(method, attempt, resp, err) {
+			if err != nil {
+				return err
+			}
+			return handleResponse(method, path, resp, response)
+		}
 
-		if err != nil {
-			return err
+		wait := c.retry.wait(attempt, resp)
+		if resp != nil {
+			log.Printf("[DEBUG] %v %v returned %v, retrying in %v", method, path, resp.StatusCode, wait)
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		} else {
+			log.Printf("[DEBUG] %v %v failed: %v, retrying in %v", method, path, err, wait)
 		}
-	} else {
-		req, err = http.NewRequest(method, c.url+path, nil)
-		if err != nil {
-			return err
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
 		}
 	}
+}
+
+func (c *Client) doRequest(ctx context.Context, path string, method string, jsonData []byte) (*http.Response, error) {
+	var bodyReader io.Reader
+	if jsonData != nil {
+		bodyReader = bytes.NewReader(jsonData)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.url+path, bodyReader)
+	if err != nil {
+		return nil, err
+	}
 
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("accept", "application/json")
@@ -152,19 +296,20 @@ func (c *Client) performRequest(path string, method string, body interface{}, re
 		req.Header.Add("Authorization", "Token "+c.token)
 	}
 
-	resp, err := c.client.Do(req)
+	return c.client.Do(req)
+}
 
-	if err != nil {
-		return err
-	}
+func handleResponse(method string, path string, resp *http.Response, response interface{}) error {
+	defer resp.Body.Close()
 
 	if resp.StatusCode/100 != 2 {
 		b, _ := io.ReadAll(resp.Body)
-		return errors.New(string(b))
+		return newAPIError(method, path, resp.StatusCode, b)
 	}
 
 	if response != nil {
-		err = json.NewDecoder(resp.Body).Decode(&response)
+		return json.NewDecoder(resp.Body).Decode(&response)
 	}
-	return err
+
+	return nil
 }
@@ -1,6 +1,8 @@
 package client
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 )
@@ -16,9 +18,9 @@ type DocumentType struct {
 	FileNameGeneratorBackendArguments string  `json:"filename_generator_backend_arguments"`
 }
 
-func (c *Client) CreateDocumentType(documentType DocumentType) (*DocumentType, error) {
+func (c *Client) CreateDocumentType(ctx context.Context, documentType DocumentType) (*DocumentType, error) {
 	var createdDoc *DocumentType
-	err := c.performRequest("document_types/", http.MethodPost, &documentType, &createdDoc)
+	err := c.performRequest(ctx, "document_types/", http.MethodPost, &documentType, &createdDoc)
 	if err != nil {
 		return &DocumentType{}, err
 	}
@@ -26,9 +28,9 @@ func (c *Client) CreateDocumentType(documentType DocumentType) (*DocumentType, e
 	return createdDoc, nil
 }
 
-func (c *Client) GetDocumentTypeById(id int) (*DocumentType, error) {
+func (c *Client) GetDocumentTypeById(ctx context.Context, id int) (*DocumentType, error) {
 	var documentType *DocumentType
-	err := c.performRequest(fmt.Sprintf("document_types/%v/", id), http.MethodGet, nil, &documentType)
+	err := c.performRequest(ctx, fmt.Sprintf("document_types/%v/", id), http.MethodGet, nil, &documentType)
 	if err != nil {
 		return &DocumentType{}, err
 	}
@@ -36,14 +38,32 @@ func (c *Client) GetDocumentTypeById(id int) (*DocumentType, error) {
 	return documentType, nil
 }
 
-func (c *Client) DeleteDocumentType(id int) error {
-	err := c.performRequest(fmt.Sprintf("document_types/%v/", id), http.MethodDelete, nil, nil)
+func (c *Client) GetDocumentTypes(ctx context.Context) ([]DocumentType, error) {
+	var documentTypes []DocumentType
+	err := c.listAll(ctx, "document_types/", func(results json.RawMessage) error {
+		var page []DocumentType
+		if err := json.Unmarshal(results, &page); err != nil {
+			return err
+		}
+
+		documentTypes = append(documentTypes, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return documentTypes, nil
+}
+
+func (c *Client) DeleteDocumentType(ctx context.Context, id int) error {
+	err := c.performRequest(ctx, fmt.Sprintf("document_types/%v/", id), http.MethodDelete, nil, nil)
 	return err
 }
 
-func (c *Client) UpdateDocumentType(documentType DocumentType) (*DocumentType, error) {
+func (c *Client) UpdateDocumentType(ctx context.Context, documentType DocumentType) (*DocumentType, error) {
 	var updatedDocType *DocumentType
-	err := c.performRequest(fmt.Sprintf("document_types/%v/", documentType.ID), http.MethodPut, &documentType, &updatedDocType)
+	err := c.performRequest(ctx, fmt.Sprintf("document_types/%v/", documentType.ID), http.MethodPut, &documentType, &updatedDocType)
 	if err != nil {
 		return &DocumentType{}, err
 	}
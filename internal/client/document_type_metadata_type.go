@@ -0,0 +1,82 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// DocumentTypeMetadataType attaches a metadata type to a document type,
+// either as a required or an optional field.
+type DocumentTypeMetadataType struct {
+	ID             int
+	MetadataTypeID int
+	Required       bool
+}
+
+type documentTypeMetadataType struct {
+	ID           int `json:"id"`
+	MetadataType struct {
+		ID int `json:"id"`
+	} `json:"metadata_type"`
+	Required bool `json:"required"`
+}
+
+func (t documentTypeMetadataType) toDocumentTypeMetadataType() *DocumentTypeMetadataType {
+	return &DocumentTypeMetadataType{
+		ID:             t.ID,
+		MetadataTypeID: t.MetadataType.ID,
+		Required:       t.Required,
+	}
+}
+
+func (c *Client) GetDocumentTypeMetadataType(ctx context.Context, documentTypeId int, id int) (*DocumentTypeMetadataType, error) {
+	var result documentTypeMetadataType
+	err := c.performRequest(ctx, fmt.Sprintf("document_types/%v/metadata_types/%v/", documentTypeId, id), http.MethodGet, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.toDocumentTypeMetadataType(), nil
+}
+
+func (c *Client) CreateDocumentTypeMetadataType(ctx context.Context, documentTypeId int, metadataType DocumentTypeMetadataType) (*DocumentTypeMetadataType, error) {
+	var result documentTypeMetadataType
+	request := struct {
+		MetadataTypeID int  `json:"metadata_type_id"`
+		Required       bool `json:"required"`
+	}{
+		MetadataTypeID: metadataType.MetadataTypeID,
+		Required:       metadataType.Required,
+	}
+	err := c.performRequest(ctx, fmt.Sprintf("document_types/%v/metadata_types/", documentTypeId), http.MethodPost, &request, &result)
+	if err != nil {
+		return &DocumentTypeMetadataType{}, err
+	}
+
+	return result.toDocumentTypeMetadataType(), nil
+}
+
+func (c *Client) UpdateDocumentTypeMetadataType(ctx context.Context, documentTypeId int, metadataType DocumentTypeMetadataType) (*DocumentTypeMetadataType, error) {
+	var result documentTypeMetadataType
+	request := struct {
+		Required bool `json:"required"`
+	}{
+		Required: metadataType.Required,
+	}
+	err := c.performRequest(ctx, fmt.Sprintf("document_types/%v/metadata_types/%v/", documentTypeId, metadataType.ID), http.MethodPut, &request, &result)
+	if err != nil {
+		return &DocumentTypeMetadataType{}, err
+	}
+
+	return result.toDocumentTypeMetadataType(), nil
+}
+
+func (c *Client) RemoveDocumentTypeMetadataType(ctx context.Context, documentTypeId int, id int) error {
+	err := c.performRequest(ctx, fmt.Sprintf("document_types/%v/metadata_types/%v/", documentTypeId, id), http.MethodDelete, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
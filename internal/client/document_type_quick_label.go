@@ -0,0 +1,55 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// DocumentTypeQuickLabel is a predefined label uploaders can pick for
+// documents of a document type. Mayan EDMS stores it as a document type
+// filename, so the label is sent as "filename".
+type DocumentTypeQuickLabel struct {
+	ID      int    `json:"id"`
+	Label   string `json:"filename"`
+	Enabled bool   `json:"enabled"`
+}
+
+func (c *Client) GetDocumentTypeQuickLabel(ctx context.Context, documentTypeId int, id int) (*DocumentTypeQuickLabel, error) {
+	var quickLabel *DocumentTypeQuickLabel
+	err := c.performRequest(ctx, fmt.Sprintf("document_types/%v/quick_labels/%v/", documentTypeId, id), http.MethodGet, nil, &quickLabel)
+	if err != nil {
+		return nil, err
+	}
+
+	return quickLabel, nil
+}
+
+func (c *Client) CreateDocumentTypeQuickLabel(ctx context.Context, documentTypeId int, quickLabel DocumentTypeQuickLabel) (*DocumentTypeQuickLabel, error) {
+	var createdQuickLabel *DocumentTypeQuickLabel
+	err := c.performRequest(ctx, fmt.Sprintf("document_types/%v/quick_labels/", documentTypeId), http.MethodPost, &quickLabel, &createdQuickLabel)
+	if err != nil {
+		return &DocumentTypeQuickLabel{}, err
+	}
+
+	return createdQuickLabel, nil
+}
+
+func (c *Client) UpdateDocumentTypeQuickLabel(ctx context.Context, documentTypeId int, quickLabel DocumentTypeQuickLabel) (*DocumentTypeQuickLabel, error) {
+	var updatedQuickLabel *DocumentTypeQuickLabel
+	err := c.performRequest(ctx, fmt.Sprintf("document_types/%v/quick_labels/%v/", documentTypeId, quickLabel.ID), http.MethodPut, &quickLabel, &updatedQuickLabel)
+	if err != nil {
+		return &DocumentTypeQuickLabel{}, err
+	}
+
+	return updatedQuickLabel, nil
+}
+
+func (c *Client) RemoveDocumentTypeQuickLabel(ctx context.Context, documentTypeId int, id int) error {
+	err := c.performRequest(ctx, fmt.Sprintf("document_types/%v/quick_labels/%v/", documentTypeId, id), http.MethodDelete, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
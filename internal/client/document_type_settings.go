@@ -0,0 +1,59 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// DocumentTypeOCRSettings controls whether documents of a document type are
+// OCRed when uploaded.
+type DocumentTypeOCRSettings struct {
+	AutoOCR bool `json:"auto_ocr"`
+}
+
+// DocumentTypeParsingSettings controls whether the text content of documents
+// of a document type is parsed when uploaded.
+type DocumentTypeParsingSettings struct {
+	AutoParsing bool `json:"auto_parsing"`
+}
+
+func (c *Client) GetDocumentTypeOCRSettings(ctx context.Context, documentTypeId int) (*DocumentTypeOCRSettings, error) {
+	var settings *DocumentTypeOCRSettings
+	err := c.performRequest(ctx, fmt.Sprintf("document_types/%v/ocr/settings/", documentTypeId), http.MethodGet, nil, &settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+func (c *Client) UpdateDocumentTypeOCRSettings(ctx context.Context, documentTypeId int, settings DocumentTypeOCRSettings) (*DocumentTypeOCRSettings, error) {
+	var updatedSettings *DocumentTypeOCRSettings
+	err := c.performRequest(ctx, fmt.Sprintf("document_types/%v/ocr/settings/", documentTypeId), http.MethodPut, &settings, &updatedSettings)
+	if err != nil {
+		return &DocumentTypeOCRSettings{}, err
+	}
+
+	return updatedSettings, nil
+}
+
+func (c *Client) GetDocumentTypeParsingSettings(ctx context.Context, documentTypeId int) (*DocumentTypeParsingSettings, error) {
+	var settings *DocumentTypeParsingSettings
+	err := c.performRequest(ctx, fmt.Sprintf("document_types/%v/parsing/settings/", documentTypeId), http.MethodGet, nil, &settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+func (c *Client) UpdateDocumentTypeParsingSettings(ctx context.Context, documentTypeId int, settings DocumentTypeParsingSettings) (*DocumentTypeParsingSettings, error) {
+	var updatedSettings *DocumentTypeParsingSettings
+	err := c.performRequest(ctx, fmt.Sprintf("document_types/%v/parsing/settings/", documentTypeId), http.MethodPut, &settings, &updatedSettings)
+	if err != nil {
+		return &DocumentTypeParsingSettings{}, err
+	}
+
+	return updatedSettings, nil
+}
@@ -0,0 +1,122 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// APIError is returned for any non-2xx response from the Mayan EDMS API.
+type APIError struct {
+	StatusCode int
+	Method     string
+	Path       string
+
+	// Detail holds the DRF "detail" message or any non field errors.
+	Detail string
+	// FieldErrors holds the DRF validation errors keyed by field name.
+	FieldErrors map[string][]string
+	// Body is the raw response body.
+	Body string
+}
+
+func (e *APIError) Error() string {
+	message := e.Detail
+	if len(e.FieldErrors) > 0 {
+		fields := make([]string, 0, len(e.FieldErrors))
+		for field := range e.FieldErrors {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+
+		var parts []string
+		for _, field := range fields {
+			parts = append(parts, fmt.Sprintf("%v: %v", field, strings.Join(e.FieldErrors[field], " ")))
+		}
+
+		if message != "" {
+			message += "; "
+		}
+		message += strings.Join(parts, "; ")
+	}
+
+	if message == "" {
+		message = strings.TrimSpace(e.Body)
+	}
+	if message == "" {
+		message = http.StatusText(e.StatusCode)
+	}
+
+	return fmt.Sprintf("%v %v returned %v: %v", e.Method, e.Path, e.StatusCode, message)
+}
+
+// IsNotFound reports whether err is an APIError with a 404 status code.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+func newAPIError(method string, path string, statusCode int, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: statusCode,
+		Method:     method,
+		Path:       path,
+		Body:       string(body),
+	}
+
+	var list []interface{}
+	if err := json.Unmarshal(body, &list); err == nil {
+		apiErr.Detail = strings.Join(errorMessages(list), " ")
+		return apiErr
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return apiErr
+	}
+
+	for field, value := range fields {
+		messages := errorMessages(value)
+		switch field {
+		case "detail", "non_field_errors":
+			if apiErr.Detail != "" {
+				apiErr.Detail += " "
+			}
+			apiErr.Detail += strings.Join(messages, " ")
+		default:
+			if apiErr.FieldErrors == nil {
+				apiErr.FieldErrors = map[string][]string{}
+			}
+			apiErr.FieldErrors[field] = messages
+		}
+	}
+
+	return apiErr
+}
+
+func errorMessages(value interface{}) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		var messages []string
+		for _, item := range v {
+			messages = append(messages, errorMessages(item)...)
+		}
+		return messages
+	case map[string]interface{}:
+		var messages []string
+		for key, item := range v {
+			for _, message := range errorMessages(item) {
+				messages = append(messages, fmt.Sprintf("%v: %v", key, message))
+			}
+		}
+		sort.Strings(messages)
+		return messages
+	default:
+		return []string{fmt.Sprint(v)}
+	}
+}
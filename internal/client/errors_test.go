@@ -0,0 +1,161 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestNewAPIError(t *testing.T) {
+	cases := []struct {
+		name        string
+		statusCode  int
+		body        string
+		detail      string
+		fieldErrors map[string][]string
+		message     string
+	}{
+		{
+			name:       "detail",
+			statusCode: http.StatusNotFound,
+			body:       `{"detail": "Not found."}`,
+			detail:     "Not found.",
+			message:    "GET tags/1/ returned 404: Not found.",
+		},
+		{
+			name:       "field errors",
+			statusCode: http.StatusBadRequest,
+			body:       `{"label": ["This field is required."], "color": ["Enter a valid color.", "Too long."]}`,
+			fieldErrors: map[string][]string{
+				"label": {"This field is required."},
+				"color": {"Enter a valid color.", "Too long."},
+			},
+			message: "GET tags/1/ returned 400: color: Enter a valid color. Too long.; label: This field is required.",
+		},
+		{
+			name:       "non field errors with field errors",
+			statusCode: http.StatusBadRequest,
+			body:       `{"non_field_errors": ["Label must be unique."], "label": "Too long."}`,
+			detail:     "Label must be unique.",
+			fieldErrors: map[string][]string{
+				"label": {"Too long."},
+			},
+			message: "GET tags/1/ returned 400: Label must be unique.; label: Too long.",
+		},
+		{
+			name:       "nested field errors",
+			statusCode: http.StatusBadRequest,
+			body:       `{"backend_data": {"port": ["Enter a number."]}}`,
+			fieldErrors: map[string][]string{
+				"backend_data": {"port: Enter a number."},
+			},
+			message: "GET tags/1/ returned 400: backend_data: port: Enter a number.",
+		},
+		{
+			name:       "list of errors",
+			statusCode: http.StatusBadRequest,
+			body:       `["Document type is in use."]`,
+			detail:     "Document type is in use.",
+			message:    "GET tags/1/ returned 400: Document type is in use.",
+		},
+		{
+			name:       "html body",
+			statusCode: http.StatusBadGateway,
+			body:       "<html><body>Bad Gateway</body></html>\n",
+			message:    "GET tags/1/ returned 502: <html><body>Bad Gateway</body></html>",
+		},
+		{
+			name:       "empty body",
+			statusCode: http.StatusInternalServerError,
+			body:       "",
+			message:    "GET tags/1/ returned 500: Internal Server Error",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := newAPIError(http.MethodGet, "tags/1/", tc.statusCode, []byte(tc.body))
+
+			if err.StatusCode != tc.statusCode {
+				t.Errorf("expected status code %v, got %v", tc.statusCode, err.StatusCode)
+			}
+			if err.Body != tc.body {
+				t.Errorf("expected body %q, got %q", tc.body, err.Body)
+			}
+			if err.Detail != tc.detail {
+				t.Errorf("expected detail %q, got %q", tc.detail, err.Detail)
+			}
+			if !reflect.DeepEqual(err.FieldErrors, tc.fieldErrors) {
+				t.Errorf("expected field errors %v, got %v", tc.fieldErrors, err.FieldErrors)
+			}
+			if err.Error() != tc.message {
+				t.Errorf("expected message %q, got %q", tc.message, err.Error())
+			}
+		})
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	notFound := &APIError{StatusCode: http.StatusNotFound}
+
+	cases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "not found", err: notFound, expected: true},
+		{name: "wrapped not found", err: fmt.Errorf("reading tag: %w", notFound), expected: true},
+		{name: "gone", err: &APIError{StatusCode: http.StatusGone}},
+		{name: "forbidden", err: &APIError{StatusCode: http.StatusForbidden}},
+		{name: "server error", err: &APIError{StatusCode: http.StatusInternalServerError}},
+		{name: "other error", err: errors.New("404 not found")},
+		{name: "no error", err: nil},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := IsNotFound(tc.err); actual != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestRequestReturnsAPIError(t *testing.T) {
+	cases := []struct {
+		name       string
+		statusCode int
+		body       string
+		notFound   bool
+	}{
+		{name: "not found", statusCode: http.StatusNotFound, body: `{"detail": "Not found."}`, notFound: true},
+		{name: "bad request", statusCode: http.StatusBadRequest, body: `{"label": ["This field is required."]}`},
+		{name: "forbidden", statusCode: http.StatusForbidden, body: `{"detail": "You do not have permission to perform this action."}`},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newTestClient(t, ClientConfig{}, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tc.statusCode)
+				_, _ = w.Write([]byte(tc.body))
+			})
+
+			_, err := c.GetTagById(context.Background(), 1)
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("expected an APIError, got %v", err)
+			}
+			if apiErr.StatusCode != tc.statusCode || apiErr.Method != http.MethodGet || apiErr.Path != "tags/1/" {
+				t.Errorf("unexpected error %#v", apiErr)
+			}
+			if IsNotFound(err) != tc.notFound {
+				t.Errorf("expected IsNotFound to be %v for %v", tc.notFound, err)
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package client
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 )
@@ -10,9 +12,9 @@ type Group struct {
 	Name string `json:"name"`
 }
 
-func (c *Client) CreateGroup(group Group) (*Group, error) {
+func (c *Client) CreateGroup(ctx context.Context, group Group) (*Group, error) {
 	var createdGroup *Group
-	err := c.performRequest("groups/", http.MethodPost, &group, &createdGroup)
+	err := c.performRequest(ctx, "groups/", http.MethodPost, &group, &createdGroup)
 	if err != nil {
 		return &Group{}, err
 	}
@@ -20,9 +22,9 @@ func (c *Client) CreateGroup(group Group) (*Group, error) {
 	return createdGroup, nil
 }
 
-func (c *Client) GetGroupById(id int) (*Group, error) {
+func (c *Client) GetGroupById(ctx context.Context, id int) (*Group, error) {
 	var group *Group
-	err := c.performRequest(fmt.Sprintf("groups/%v/", id), http.MethodGet, nil, &group)
+	err := c.performRequest(ctx, fmt.Sprintf("groups/%v/", id), http.MethodGet, nil, &group)
 	if err != nil {
 		return &Group{}, err
 	}
@@ -30,14 +32,32 @@ func (c *Client) GetGroupById(id int) (*Group, error) {
 	return group, nil
 }
 
-func (c *Client) DeleteGroup(id int) error {
-	err := c.performRequest(fmt.Sprintf("groups/%v/", id), http.MethodDelete, nil, nil)
+func (c *Client) GetGroups(ctx context.Context) ([]Group, error) {
+	var groups []Group
+	err := c.listAll(ctx, "groups/", func(results json.RawMessage) error {
+		var page []Group
+		if err := json.Unmarshal(results, &page); err != nil {
+			return err
+		}
+
+		groups = append(groups, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
+
+func (c *Client) DeleteGroup(ctx context.Context, id int) error {
+	err := c.performRequest(ctx, fmt.Sprintf("groups/%v/", id), http.MethodDelete, nil, nil)
 	return err
 }
 
-func (c *Client) UpdateGroup(group Group) (*Group, error) {
+func (c *Client) UpdateGroup(ctx context.Context, group Group) (*Group, error) {
 	var updatedGroup *Group
-	err := c.performRequest(fmt.Sprintf("groups/%v/", group.ID), http.MethodPut, &group, &updatedGroup)
+	err := c.performRequest(ctx, fmt.Sprintf("groups/%v/", group.ID), http.MethodPut, &group, &updatedGroup)
 	if err != nil {
 		return &Group{}, err
 	}
@@ -45,33 +65,18 @@ func (c *Client) UpdateGroup(group Group) (*Group, error) {
 	return updatedGroup, nil
 }
 
-func (c *Client) GetGroupUsers(groupId int) ([]int, error) {
-	var results struct {
-		Results []struct {
-			ID int `json:"id"`
-		} `json:"results"`
-	}
-	err := c.performRequest(fmt.Sprintf("groups/%v/users/", groupId), http.MethodGet, nil, &results)
-	if err != nil {
-		return nil, err
-	}
-
-	var ids []int
-	for _, result := range results.Results {
-		ids = append(ids, result.ID)
-	}
-
-	return ids, nil
+func (c *Client) GetGroupUsers(ctx context.Context, groupId int) ([]int, error) {
+	return c.listAllIds(ctx, fmt.Sprintf("groups/%v/users/", groupId))
 }
 
-func (c *Client) AddGroupUser(groupId int, userId int) error {
+func (c *Client) AddGroupUser(ctx context.Context, groupId int, userId int) error {
 
 	var request struct {
 		UserId int `json:"user"`
 	}
 	request.UserId = userId
 
-	err := c.performRequest(fmt.Sprintf("groups/%v/users/add/", groupId), http.MethodPost, &request, nil)
+	err := c.performRequest(ctx, fmt.Sprintf("groups/%v/users/add/", groupId), http.MethodPost, &request, nil)
 	if err != nil {
 		return err
 	}
@@ -79,14 +84,14 @@ func (c *Client) AddGroupUser(groupId int, userId int) error {
 	return nil
 }
 
-func (c *Client) RemoveGroupUser(groupId int, userId int) error {
+func (c *Client) RemoveGroupUser(ctx context.Context, groupId int, userId int) error {
 
 	var request struct {
 		UserId int `json:"user"`
 	}
 	request.UserId = userId
 
-	err := c.performRequest(fmt.Sprintf("groups/%v/users/remove/", groupId), http.MethodPost, &request, nil)
+	err := c.performRequest(ctx, fmt.Sprintf("groups/%v/users/remove/", groupId), http.MethodPost, &request, nil)
 	if err != nil {
 		return err
 	}
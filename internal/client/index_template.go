@@ -1,6 +1,8 @@
 package client
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 )
@@ -25,9 +27,9 @@ type IndexTemplateNode struct {
 	Parent int `json:"parent"`
 }
 
-func (c *Client) CreateIndexTemplate(tag IndexTemplate) (*IndexTemplate, error) {
+func (c *Client) CreateIndexTemplate(ctx context.Context, tag IndexTemplate) (*IndexTemplate, error) {
 	var createdIndex *IndexTemplate
-	err := c.performRequest("index_templates/", http.MethodPost, &tag, &createdIndex)
+	err := c.performRequest(ctx, "index_templates/", http.MethodPost, &tag, &createdIndex)
 	if err != nil {
 		return &IndexTemplate{}, err
 	}
@@ -35,9 +37,9 @@ func (c *Client) CreateIndexTemplate(tag IndexTemplate) (*IndexTemplate, error)
 	return createdIndex, nil
 }
 
-func (c *Client) GetIndexTemplateById(id int) (*IndexTemplate, error) {
+func (c *Client) GetIndexTemplateById(ctx context.Context, id int) (*IndexTemplate, error) {
 	var tag *IndexTemplate
-	err := c.performRequest(fmt.Sprintf("index_templates/%v/", id), http.MethodGet, nil, &tag)
+	err := c.performRequest(ctx, fmt.Sprintf("index_templates/%v/", id), http.MethodGet, nil, &tag)
 	if err != nil {
 		return &IndexTemplate{}, err
 	}
@@ -45,14 +47,32 @@ func (c *Client) GetIndexTemplateById(id int) (*IndexTemplate, error) {
 	return tag, nil
 }
 
-func (c *Client) DeleteIndexTemplate(id int) error {
-	err := c.performRequest(fmt.Sprintf("index_templates/%v/", id), http.MethodDelete, nil, nil)
+func (c *Client) GetIndexTemplates(ctx context.Context) ([]IndexTemplate, error) {
+	var indexTemplates []IndexTemplate
+	err := c.listAll(ctx, "index_templates/", func(results json.RawMessage) error {
+		var page []IndexTemplate
+		if err := json.Unmarshal(results, &page); err != nil {
+			return err
+		}
+
+		indexTemplates = append(indexTemplates, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return indexTemplates, nil
+}
+
+func (c *Client) DeleteIndexTemplate(ctx context.Context, id int) error {
+	err := c.performRequest(ctx, fmt.Sprintf("index_templates/%v/", id), http.MethodDelete, nil, nil)
 	return err
 }
 
-func (c *Client) UpdateIndexTemplate(documentType IndexTemplate) (*IndexTemplate, error) {
+func (c *Client) UpdateIndexTemplate(ctx context.Context, documentType IndexTemplate) (*IndexTemplate, error) {
 	var updatedIndexTemplate *IndexTemplate
-	err := c.performRequest(fmt.Sprintf("index_templates/%v/", documentType.ID), http.MethodPut, &documentType, &updatedIndexTemplate)
+	err := c.performRequest(ctx, fmt.Sprintf("index_templates/%v/", documentType.ID), http.MethodPut, &documentType, &updatedIndexTemplate)
 	if err != nil {
 		return &IndexTemplate{}, err
 	}
@@ -60,33 +80,18 @@ func (c *Client) UpdateIndexTemplate(documentType IndexTemplate) (*IndexTemplate
 	return updatedIndexTemplate, nil
 }
 
-func (c *Client) GetIndexTemplateDocumentTypes(indexTemplateId int) ([]int, error) {
-	var results struct {
-		Results []struct {
-			ID int `json:"id"`
-		} `json:"results"`
-	}
-	err := c.performRequest(fmt.Sprintf("index_templates/%v/document_types/", indexTemplateId), http.MethodGet, nil, &results)
-	if err != nil {
-		return nil, err
-	}
-
-	var ids []int
-	for _, result := range results.Results {
-		ids = append(ids, result.ID)
-	}
-
-	return ids, nil
+func (c *Client) GetIndexTemplateDocumentTypes(ctx context.Context, indexTemplateId int) ([]int, error) {
+	return c.listAllIds(ctx, fmt.Sprintf("index_templates/%v/document_types/", indexTemplateId))
 }
 
-func (c *Client) AddIndexTemplateDocumentType(indexTemplateId int, documentTypeId int) error {
+func (c *Client) AddIndexTemplateDocumentType(ctx context.Context, indexTemplateId int, documentTypeId int) error {
 
 	var request struct {
 		DocumentTypeId int `json:"document_type"`
 	}
 	request.DocumentTypeId = documentTypeId
 
-	err := c.performRequest(fmt.Sprintf("index_templates/%v/document_types/add/", indexTemplateId), http.MethodPost, &request, nil)
+	err := c.performRequest(ctx, fmt.Sprintf("index_templates/%v/document_types/add/", indexTemplateId), http.MethodPost, &request, nil)
 	if err != nil {
 		return err
 	}
@@ -94,14 +99,14 @@ func (c *Client) AddIndexTemplateDocumentType(indexTemplateId int, documentTypeI
 	return nil
 }
 
-func (c *Client) RemoveIndexTemplateDocumentType(indexTemplateId int, documentTypeId int) error {
+func (c *Client) RemoveIndexTemplateDocumentType(ctx context.Context, indexTemplateId int, documentTypeId int) error {
 
 	var request struct {
 		DocumentTypeId int `json:"document_type"`
 	}
 	request.DocumentTypeId = documentTypeId
 
-	err := c.performRequest(fmt.Sprintf("index_templates/%v/document_types/remove/", indexTemplateId), http.MethodPost, &request, nil)
+	err := c.performRequest(ctx, fmt.Sprintf("index_templates/%v/document_types/remove/", indexTemplateId), http.MethodPost, &request, nil)
 	if err != nil {
 		return err
 	}
@@ -109,9 +114,9 @@ func (c *Client) RemoveIndexTemplateDocumentType(indexTemplateId int, documentTy
 	return nil
 }
 
-func (c *Client) CreateIndexTemplateNode(indexTemplateNode IndexTemplateNode) (*IndexTemplateNode, error) {
+func (c *Client) CreateIndexTemplateNode(ctx context.Context, indexTemplateNode IndexTemplateNode) (*IndexTemplateNode, error) {
 	var createdIndexTemplateNode *IndexTemplateNode
-	err := c.performRequest(fmt.Sprintf("index_templates/%v/nodes/", indexTemplateNode.IndexID), http.MethodPost, &indexTemplateNode, &createdIndexTemplateNode)
+	err := c.performRequest(ctx, fmt.Sprintf("index_templates/%v/nodes/", indexTemplateNode.IndexID), http.MethodPost, &indexTemplateNode, &createdIndexTemplateNode)
 	if err != nil {
 		return &IndexTemplateNode{}, err
 	}
@@ -119,9 +124,9 @@ func (c *Client) CreateIndexTemplateNode(indexTemplateNode IndexTemplateNode) (*
 	return createdIndexTemplateNode, nil
 }
 
-func (c *Client) GetIndexTemplateNodeById(indexId, indexNodeId int) (*IndexTemplateNode, error) {
+func (c *Client) GetIndexTemplateNodeById(ctx context.Context, indexId, indexNodeId int) (*IndexTemplateNode, error) {
 	var indexTemplateNode *IndexTemplateNode
-	err := c.performRequest(fmt.Sprintf("index_templates/%v/nodes/%v/", indexId, indexNodeId), http.MethodGet, nil, &indexTemplateNode)
+	err := c.performRequest(ctx, fmt.Sprintf("index_templates/%v/nodes/%v/", indexId, indexNodeId), http.MethodGet, nil, &indexTemplateNode)
 	if err != nil {
 		return &IndexTemplateNode{}, err
 	}
@@ -129,17 +134,42 @@ func (c *Client) GetIndexTemplateNodeById(indexId, indexNodeId int) (*IndexTempl
 	return indexTemplateNode, nil
 }
 
-func (c *Client) DeleteIndexTemplateNode(indexId, indexNodeId int) error {
-	err := c.performRequest(fmt.Sprintf("index_templates/%v/nodes/%v/", indexId, indexNodeId), http.MethodDelete, nil, nil)
+func (c *Client) GetIndexTemplateNodes(ctx context.Context, indexId int) ([]IndexTemplateNode, error) {
+	var nodes []IndexTemplateNode
+	err := c.listAll(ctx, fmt.Sprintf("index_templates/%v/nodes/", indexId), func(results json.RawMessage) error {
+		var page []IndexTemplateNode
+		if err := json.Unmarshal(results, &page); err != nil {
+			return err
+		}
+
+		nodes = append(nodes, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return nodes, nil
+}
+
+func (c *Client) DeleteIndexTemplateNode(ctx context.Context, indexId, indexNodeId int) error {
+	err := c.performRequest(ctx, fmt.Sprintf("index_templates/%v/nodes/%v/", indexId, indexNodeId), http.MethodDelete, nil, nil)
 	return err
 }
 
-func (c *Client) UpdateIndexTemplateNode(indexTemplateId int, indexTemplateNode IndexTemplateNode) (*IndexTemplateNode, error) {
+func (c *Client) UpdateIndexTemplateNode(ctx context.Context, indexTemplateId int, indexTemplateNode IndexTemplateNode) (*IndexTemplateNode, error) {
 	var updatedIndexTemplateNode *IndexTemplateNode
-	err := c.performRequest(fmt.Sprintf("index_templates/%v/nodes/%v/", indexTemplateId, indexTemplateNode.ID), http.MethodPut, &indexTemplateNode, &updatedIndexTemplateNode)
+	err := c.performRequest(ctx, fmt.Sprintf("index_templates/%v/nodes/%v/", indexTemplateId, indexTemplateNode.ID), http.MethodPut, &indexTemplateNode, &updatedIndexTemplateNode)
 	if err != nil {
 		return &IndexTemplateNode{}, err
 	}
 
 	return updatedIndexTemplateNode, nil
 }
+
+// RebuildIndexTemplate queues a rebuild of the instances of an index template.
+// The rebuild runs in the background once the request is accepted.
+func (c *Client) RebuildIndexTemplate(ctx context.Context, id int) error {
+	err := c.performRequest(ctx, fmt.Sprintf("index_templates/%v/rebuild/", id), http.MethodPost, nil, nil)
+	return err
+}
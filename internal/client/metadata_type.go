@@ -1,6 +1,8 @@
 package client
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 )
@@ -15,9 +17,9 @@ type MetadataType struct {
 	Parser    string `json:"parser"`
 }
 
-func (c *Client) CreateMetadataType(metadataType MetadataType) (*MetadataType, error) {
+func (c *Client) CreateMetadataType(ctx context.Context, metadataType MetadataType) (*MetadataType, error) {
 	var createdType *MetadataType
-	err := c.performRequest("metadata_types/", http.MethodPost, &metadataType, &createdType)
+	err := c.performRequest(ctx, "metadata_types/", http.MethodPost, &metadataType, &createdType)
 	if err != nil {
 		return &MetadataType{}, err
 	}
@@ -25,9 +27,9 @@ func (c *Client) CreateMetadataType(metadataType MetadataType) (*MetadataType, e
 	return createdType, nil
 }
 
-func (c *Client) GetMetadataTypeById(id int) (*MetadataType, error) {
+func (c *Client) GetMetadataTypeById(ctx context.Context, id int) (*MetadataType, error) {
 	var metadataType *MetadataType
-	err := c.performRequest(fmt.Sprintf("metadata_types/%v/", id), http.MethodGet, nil, &metadataType)
+	err := c.performRequest(ctx, fmt.Sprintf("metadata_types/%v/", id), http.MethodGet, nil, &metadataType)
 	if err != nil {
 		return &MetadataType{}, err
 	}
@@ -35,14 +37,32 @@ func (c *Client) GetMetadataTypeById(id int) (*MetadataType, error) {
 	return metadataType, nil
 }
 
-func (c *Client) DeleteMetadataType(id int) error {
-	err := c.performRequest(fmt.Sprintf("metadata_types/%v/", id), http.MethodDelete, nil, nil)
+func (c *Client) GetMetadataTypes(ctx context.Context) ([]MetadataType, error) {
+	var metadataTypes []MetadataType
+	err := c.listAll(ctx, "metadata_types/", func(results json.RawMessage) error {
+		var page []MetadataType
+		if err := json.Unmarshal(results, &page); err != nil {
+			return err
+		}
+
+		metadataTypes = append(metadataTypes, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return metadataTypes, nil
+}
+
+func (c *Client) DeleteMetadataType(ctx context.Context, id int) error {
+	err := c.performRequest(ctx, fmt.Sprintf("metadata_types/%v/", id), http.MethodDelete, nil, nil)
 	return err
 }
 
-func (c *Client) UpdateMetadataType(metadataType MetadataType) (*MetadataType, error) {
+func (c *Client) UpdateMetadataType(ctx context.Context, metadataType MetadataType) (*MetadataType, error) {
 	var updatedMetadataType *MetadataType
-	err := c.performRequest(fmt.Sprintf("metadata_types/%v/", metadataType.ID), http.MethodPut, &metadataType, &updatedMetadataType)
+	err := c.performRequest(ctx, fmt.Sprintf("metadata_types/%v/", metadataType.ID), http.MethodPut, &metadataType, &updatedMetadataType)
 	if err != nil {
 		return &MetadataType{}, err
 	}
@@ -0,0 +1,106 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const defaultPageSize = 100
+
+type page struct {
+	Count    int             `json:"count"`
+	Next     *string         `json:"next"`
+	Previous *string         `json:"previous"`
+	Results  json.RawMessage `json:"results"`
+}
+
+// listAll requests every page of a DRF list endpoint, passing the raw results
+// of each page to handle until the server stops returning a next link.
+func (c *Client) listAll(ctx context.Context, path string, handle func(results json.RawMessage) error) error {
+	pageNumber := 1
+	for {
+		var current page
+		err := c.performRequest(ctx, c.pagePath(path, pageNumber), http.MethodGet, nil, &current)
+		if err != nil {
+			return err
+		}
+
+		if len(current.Results) > 0 {
+			if err := handle(current.Results); err != nil {
+				return err
+			}
+		}
+
+		if current.Next == nil || *current.Next == "" {
+			return nil
+		}
+
+		next, err := nextPageNumber(*current.Next, pageNumber)
+		if err != nil {
+			return err
+		}
+		pageNumber = next
+	}
+}
+
+// listAllIds collects the "id" field of every item in a list endpoint.
+func (c *Client) listAllIds(ctx context.Context, path string) ([]int, error) {
+	var ids []int
+	err := c.listAll(ctx, path, func(results json.RawMessage) error {
+		var items []struct {
+			ID int `json:"id"`
+		}
+		if err := json.Unmarshal(results, &items); err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			ids = append(ids, item.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+func (c *Client) pagePath(path string, pageNumber int) string {
+	separator := "?"
+	if strings.Contains(path, "?") {
+		separator = "&"
+	}
+
+	return fmt.Sprintf("%v%vpage=%v&page_size=%v", path, separator, pageNumber, c.pageSize)
+}
+
+// nextPageNumber reads the page number out of a DRF next link. The link itself
+// is not followed as Mayan may report an internal hostname behind a proxy.
+func nextPageNumber(next string, current int) (int, error) {
+	nextUrl, err := url.Parse(next)
+	if err != nil {
+		return 0, fmt.Errorf("invalid next page link %q: %v", next, err)
+	}
+
+	value := nextUrl.Query().Get("page")
+	if value == "" {
+		return current + 1, nil
+	}
+
+	pageNumber, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid page number in next page link %q: %v", next, err)
+	}
+
+	if pageNumber <= current {
+		return 0, fmt.Errorf("next page link %q does not advance past page %v", next, current)
+	}
+
+	return pageNumber, nil
+}
@@ -0,0 +1,214 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+// newTestClient returns a client talking to a test server serving handler.
+func newTestClient(t *testing.T, config ClientConfig, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	config.Url = server.URL
+	config.Token = "token"
+	c, err := NewMayanEdmsClient(context.Background(), config)
+	if err != nil {
+		t.Fatalf("unable to create client: %v", err)
+	}
+
+	return c.(*Client)
+}
+
+// writePage writes a DRF page holding the given ids.
+func writePage(t *testing.T, w http.ResponseWriter, next *string, ids ...int) {
+	t.Helper()
+
+	results := make([]map[string]int, 0, len(ids))
+	for _, id := range ids {
+		results = append(results, map[string]int{"id": id})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(map[string]interface{}{
+		"count":    len(ids),
+		"next":     next,
+		"previous": nil,
+		"results":  results,
+	}); err != nil {
+		t.Errorf("unable to write page: %v", err)
+	}
+}
+
+func stringPointer(value string) *string {
+	return &value
+}
+
+func TestListAllFollowsNextPages(t *testing.T) {
+	var pages []string
+	c := newTestClient(t, ClientConfig{PageSize: 2}, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v4/tags/" {
+			t.Errorf("unexpected path %v", r.URL.Path)
+		}
+		if size := r.URL.Query().Get("page_size"); size != "2" {
+			t.Errorf("expected page_size 2, got %q", size)
+		}
+
+		page := r.URL.Query().Get("page")
+		pages = append(pages, page)
+		switch page {
+		case "1":
+			writePage(t, w, stringPointer("http://"+r.Host+"/api/v4/tags/?page=2&page_size=2"), 1, 2)
+		case "2":
+			writePage(t, w, stringPointer("http://"+r.Host+"/api/v4/tags/?page=3&page_size=2"), 3, 4)
+		case "3":
+			writePage(t, w, nil, 5)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	ids, err := c.listAllIds(context.Background(), "tags/")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(ids, []int{1, 2, 3, 4, 5}) {
+		t.Errorf("expected ids 1 to 5, got %v", ids)
+	}
+	if !reflect.DeepEqual(pages, []string{"1", "2", "3"}) {
+		t.Errorf("expected pages 1 to 3, got %v", pages)
+	}
+}
+
+func TestListAllIgnoresNextHost(t *testing.T) {
+	var requests []string
+	c := newTestClient(t, ClientConfig{}, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.RequestURI())
+		switch r.URL.Query().Get("page") {
+		case "1":
+			// Behind a proxy Mayan reports its internal host name and prefix.
+			writePage(t, w, stringPointer("http://mayan.internal:8000/edms/api/v4/tags/?page=2"), 1)
+		default:
+			writePage(t, w, nil, 2)
+		}
+	})
+
+	ids, err := c.listAllIds(context.Background(), "tags/?label=invoice")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(ids, []int{1, 2}) {
+		t.Errorf("expected ids 1 and 2, got %v", ids)
+	}
+
+	expected := []string{
+		"/api/v4/tags/?label=invoice&page=1&page_size=100",
+		"/api/v4/tags/?label=invoice&page=2&page_size=100",
+	}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Errorf("expected requests %v, got %v", expected, requests)
+	}
+}
+
+func TestListAllEmptyResults(t *testing.T) {
+	requests := 0
+	c := newTestClient(t, ClientConfig{}, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		writePage(t, w, nil)
+	})
+
+	ids, err := c.listAllIds(context.Background(), "tags/")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if requests != 1 {
+		t.Errorf("expected a single request, got %v", requests)
+	}
+	if len(ids) != 0 {
+		t.Errorf("expected no ids, got %v", ids)
+	}
+}
+
+func TestListAllMalformedNext(t *testing.T) {
+	cases := []struct {
+		name string
+		next string
+	}{
+		{name: "unparsable link", next: "http://[::1"},
+		{name: "page is not a number", next: "/api/v4/tags/?page=two"},
+		{name: "page does not advance", next: "/api/v4/tags/?page=1"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			requests := 0
+			c := newTestClient(t, ClientConfig{}, func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				writePage(t, w, stringPointer(tc.next), requests)
+			})
+
+			_, err := c.listAllIds(context.Background(), "tags/")
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if requests != 1 {
+				t.Errorf("expected a single request, got %v", requests)
+			}
+		})
+	}
+}
+
+func TestNextPageNumber(t *testing.T) {
+	cases := []struct {
+		next     string
+		current  int
+		expected int
+		err      bool
+	}{
+		{next: "http://mayan/api/v4/tags/?page=2", current: 1, expected: 2},
+		{next: "/api/v4/tags/?page_size=10&page=5", current: 4, expected: 5},
+		{next: "http://mayan/api/v4/tags/", current: 3, expected: 4},
+		{next: "http://mayan/api/v4/tags/?page=3", current: 3, err: true},
+		{next: "http://mayan/api/v4/tags/?page=x", current: 1, err: true},
+		{next: "%zz", current: 1, err: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.next+"@"+strconv.Itoa(tc.current), func(t *testing.T) {
+			pageNumber, err := nextPageNumber(tc.next, tc.current)
+			if tc.err {
+				if err == nil {
+					t.Fatalf("expected an error, got page %v", pageNumber)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if pageNumber != tc.expected {
+				t.Errorf("expected page %v, got %v", tc.expected, pageNumber)
+			}
+		})
+	}
+}
+
+func TestPagePath(t *testing.T) {
+	c := &Client{pageSize: 50}
+
+	if path := c.pagePath("tags/", 1); path != "tags/?page=1&page_size=50" {
+		t.Errorf("unexpected path %v", path)
+	}
+	if path := c.pagePath("acls/?role=3", 2); path != "acls/?role=3&page=2&page_size=50" {
+		t.Errorf("unexpected path %v", path)
+	}
+}
@@ -0,0 +1,141 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+const (
+	defaultRetryMinWait = 1 * time.Second
+	defaultRetryMaxWait = 30 * time.Second
+)
+
+// RetryPolicy controls how failed requests to the Mayan EDMS API are retried.
+type RetryPolicy struct {
+	// MaxRetries is the number of additional attempts made after the first one.
+	MaxRetries int
+	// MinWait is the base delay used for the exponential backoff.
+	MinWait time.Duration
+	// MaxWait caps both the backoff delay and any Retry-After value.
+	MaxWait time.Duration
+	// RetryAllMethods allows non idempotent requests such as POST to be retried.
+	RetryAllMethods bool
+}
+
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	if p.MaxRetries < 0 {
+		p.MaxRetries = 0
+	}
+	if p.MinWait <= 0 {
+		p.MinWait = defaultRetryMinWait
+	}
+	if p.MaxWait <= 0 {
+		p.MaxWait = defaultRetryMaxWait
+	}
+	if p.MinWait > p.MaxWait {
+		p.MinWait = p.MaxWait
+	}
+
+	return p
+}
+
+// shouldRetry reports whether a request that failed with the given response or
+// error may be attempted again.
+func (p RetryPolicy) shouldRetry(method string, attempt int, resp *http.Response, err error) bool {
+	if attempt >= p.MaxRetries {
+		return false
+	}
+
+	if !p.RetryAllMethods && !isIdempotent(method) {
+		return false
+	}
+
+	if err != nil {
+		return isTransientError(err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+
+	return false
+}
+
+// wait returns the delay before the next attempt. Retry-After is honoured when
+// present, otherwise an exponential backoff with jitter is used.
+func (p RetryPolicy) wait(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			if retryAfter > p.MaxWait {
+				return p.MaxWait
+			}
+			return retryAfter
+		}
+	}
+
+	backoff := p.MinWait << uint(attempt)
+	if backoff <= 0 || backoff > p.MaxWait {
+		backoff = p.MaxWait
+	}
+
+	// Full jitter within the upper half of the window keeps some spacing
+	// between attempts while spreading out concurrent clients.
+	half := backoff / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
+
+// isTransientError reports whether a transport error is likely to succeed on a
+// later attempt, such as a connection reset while gunicorn recycles workers.
+func isTransientError(err error) bool {
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
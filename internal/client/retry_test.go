@@ -0,0 +1,230 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+	"testing"
+	"time"
+)
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestShouldRetry(t *testing.T) {
+	policy := RetryPolicy{MaxRetries: 2}.withDefaults()
+	allMethods := RetryPolicy{MaxRetries: 2, RetryAllMethods: true}.withDefaults()
+	status := func(code int) *http.Response {
+		return &http.Response{StatusCode: code}
+	}
+
+	cases := []struct {
+		name     string
+		policy   RetryPolicy
+		method   string
+		attempt  int
+		resp     *http.Response
+		err      error
+		expected bool
+	}{
+		{name: "service unavailable", policy: policy, method: http.MethodGet, resp: status(http.StatusServiceUnavailable), expected: true},
+		{name: "too many requests", policy: policy, method: http.MethodGet, resp: status(http.StatusTooManyRequests), expected: true},
+		{name: "bad gateway", policy: policy, method: http.MethodDelete, resp: status(http.StatusBadGateway), expected: true},
+		{name: "gateway timeout", policy: policy, method: http.MethodPut, resp: status(http.StatusGatewayTimeout), expected: true},
+		{name: "internal server error", policy: policy, method: http.MethodGet, resp: status(http.StatusInternalServerError)},
+		{name: "not found", policy: policy, method: http.MethodGet, resp: status(http.StatusNotFound)},
+		{name: "success", policy: policy, method: http.MethodGet, resp: status(http.StatusOK)},
+		{name: "last attempt", policy: policy, method: http.MethodGet, attempt: 2, resp: status(http.StatusServiceUnavailable)},
+		{name: "retries disabled", policy: RetryPolicy{}.withDefaults(), method: http.MethodGet, resp: status(http.StatusServiceUnavailable)},
+		{name: "post", policy: policy, method: http.MethodPost, resp: status(http.StatusServiceUnavailable)},
+		{name: "patch", policy: policy, method: http.MethodPatch, resp: status(http.StatusServiceUnavailable)},
+		{name: "post connection reset", policy: policy, method: http.MethodPost, err: syscall.ECONNRESET},
+		{name: "post with all methods", policy: allMethods, method: http.MethodPost, resp: status(http.StatusServiceUnavailable), expected: true},
+		{name: "connection reset", policy: policy, method: http.MethodGet, err: fmt.Errorf("read: %w", syscall.ECONNRESET), expected: true},
+		{name: "connection refused", policy: policy, method: http.MethodGet, err: syscall.ECONNREFUSED, expected: true},
+		{name: "unexpected eof", policy: policy, method: http.MethodGet, err: io.ErrUnexpectedEOF, expected: true},
+		{name: "timeout", policy: policy, method: http.MethodGet, err: &net.OpError{Op: "dial", Err: timeoutError{}}, expected: true},
+		{name: "canceled", policy: policy, method: http.MethodGet, err: context.Canceled},
+		{name: "other error", policy: policy, method: http.MethodGet, err: errors.New("unsupported protocol scheme")},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := tc.policy.shouldRetry(tc.method, tc.attempt, tc.resp, tc.err); actual != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestWaitBackoff(t *testing.T) {
+	policy := RetryPolicy{MinWait: time.Second, MaxWait: 10 * time.Second}.withDefaults()
+
+	cases := []struct {
+		attempt int
+		max     time.Duration
+	}{
+		{attempt: 0, max: time.Second},
+		{attempt: 1, max: 2 * time.Second},
+		{attempt: 3, max: 8 * time.Second},
+		{attempt: 4, max: 10 * time.Second},
+		{attempt: 100, max: 10 * time.Second},
+	}
+
+	for _, tc := range cases {
+		t.Run(fmt.Sprint(tc.attempt), func(t *testing.T) {
+			for i := 0; i < 100; i++ {
+				wait := policy.wait(tc.attempt, nil)
+				if wait < tc.max/2 || wait > tc.max {
+					t.Fatalf("expected a wait between %v and %v, got %v", tc.max/2, tc.max, wait)
+				}
+			}
+		})
+	}
+}
+
+func TestWaitRetryAfter(t *testing.T) {
+	policy := RetryPolicy{MinWait: time.Second, MaxWait: 30 * time.Second}.withDefaults()
+
+	cases := []struct {
+		name       string
+		retryAfter string
+		min        time.Duration
+		max        time.Duration
+	}{
+		{name: "seconds", retryAfter: "5", min: 5 * time.Second, max: 5 * time.Second},
+		{name: "zero seconds", retryAfter: "0", min: 0, max: 0},
+		{name: "seconds above the maximum", retryAfter: "120", min: 30 * time.Second, max: 30 * time.Second},
+		{name: "http date", retryAfter: time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat), min: 8 * time.Second, max: 10 * time.Second},
+		{name: "http date in the past", retryAfter: time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat), min: 0, max: 0},
+		{name: "http date above the maximum", retryAfter: time.Now().Add(time.Hour).UTC().Format(http.TimeFormat), min: 30 * time.Second, max: 30 * time.Second},
+		{name: "negative seconds fall back to backoff", retryAfter: "-1", min: time.Second / 2, max: time.Second},
+		{name: "invalid value falls back to backoff", retryAfter: "soon", min: time.Second / 2, max: time.Second},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := &http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{}}
+			resp.Header.Set("Retry-After", tc.retryAfter)
+
+			wait := policy.wait(0, resp)
+			if wait < tc.min || wait > tc.max {
+				t.Errorf("expected a wait between %v and %v, got %v", tc.min, tc.max, wait)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyDefaults(t *testing.T) {
+	policy := RetryPolicy{MaxRetries: -1, MinWait: time.Minute, MaxWait: 5 * time.Second}.withDefaults()
+	if policy.MaxRetries != 0 || policy.MinWait != 5*time.Second || policy.MaxWait != 5*time.Second {
+		t.Errorf("unexpected policy %+v", policy)
+	}
+
+	policy = RetryPolicy{}.withDefaults()
+	if policy.MinWait != defaultRetryMinWait || policy.MaxWait != defaultRetryMaxWait {
+		t.Errorf("unexpected policy %+v", policy)
+	}
+}
+
+func TestIsIdempotent(t *testing.T) {
+	cases := map[string]bool{
+		http.MethodGet:     true,
+		http.MethodHead:    true,
+		http.MethodOptions: true,
+		http.MethodPut:     true,
+		http.MethodDelete:  true,
+		http.MethodPost:    false,
+		http.MethodPatch:   false,
+	}
+
+	for method, expected := range cases {
+		if actual := isIdempotent(method); actual != expected {
+			t.Errorf("%v: expected %v, got %v", method, expected, actual)
+		}
+	}
+}
+
+func TestPerformRequestRetries(t *testing.T) {
+	cases := []struct {
+		name     string
+		method   string
+		config   ClientConfig
+		requests int
+	}{
+		{name: "get is retried", method: http.MethodGet, config: ClientConfig{MaxRetries: 2}, requests: 3},
+		{name: "delete is retried", method: http.MethodDelete, config: ClientConfig{MaxRetries: 1}, requests: 2},
+		{name: "post is not retried", method: http.MethodPost, config: ClientConfig{MaxRetries: 2}, requests: 1},
+		{name: "post is retried with all methods", method: http.MethodPost, config: ClientConfig{MaxRetries: 2, RetryAllMethods: true}, requests: 3},
+		{name: "no retries", method: http.MethodGet, config: ClientConfig{}, requests: 1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			requests := 0
+			c := newTestClient(t, tc.config, func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusServiceUnavailable)
+			})
+
+			err := c.performRequest(context.Background(), "tags/", tc.method, nil, nil)
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+				t.Errorf("expected a service unavailable error, got %v", err)
+			}
+			if requests != tc.requests {
+				t.Errorf("expected %v requests, got %v", tc.requests, requests)
+			}
+		})
+	}
+}
+
+func TestPerformRequestRecovers(t *testing.T) {
+	requests := 0
+	c := newTestClient(t, ClientConfig{MaxRetries: 3}, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id": 1, "label": "invoice", "color": "#ff0000"}`))
+	})
+
+	tag, err := c.GetTagById(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if tag.Label != "invoice" || requests != 3 {
+		t.Errorf("expected the third attempt to succeed, got %+v after %v requests", tag, requests)
+	}
+}
+
+func TestPerformRequestStopsWhenCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	requests := 0
+	c := newTestClient(t, ClientConfig{MaxRetries: 5}, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		cancel()
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	err := c.performRequest(ctx, "tags/", http.MethodGet, nil, nil)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if requests != 1 {
+		t.Errorf("expected a single request, got %v", requests)
+	}
+}
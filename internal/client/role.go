@@ -1,6 +1,8 @@
 package client
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 )
@@ -10,9 +12,9 @@ type Role struct {
 	Label string `json:"label"`
 }
 
-func (c *Client) CreateRole(role Role) (*Role, error) {
+func (c *Client) CreateRole(ctx context.Context, role Role) (*Role, error) {
 	var createdDoc *Role
-	err := c.performRequest("roles/", http.MethodPost, &role, &createdDoc)
+	err := c.performRequest(ctx, "roles/", http.MethodPost, &role, &createdDoc)
 	if err != nil {
 		return &Role{}, err
 	}
@@ -20,9 +22,9 @@ func (c *Client) CreateRole(role Role) (*Role, error) {
 	return createdDoc, nil
 }
 
-func (c *Client) GetRoleById(id int) (*Role, error) {
+func (c *Client) GetRoleById(ctx context.Context, id int) (*Role, error) {
 	var role *Role
-	err := c.performRequest(fmt.Sprintf("roles/%v/", id), http.MethodGet, nil, &role)
+	err := c.performRequest(ctx, fmt.Sprintf("roles/%v/", id), http.MethodGet, nil, &role)
 	if err != nil {
 		return &Role{}, err
 	}
@@ -30,14 +32,32 @@ func (c *Client) GetRoleById(id int) (*Role, error) {
 	return role, nil
 }
 
-func (c *Client) DeleteRole(id int) error {
-	err := c.performRequest(fmt.Sprintf("roles/%v/", id), http.MethodDelete, nil, nil)
+func (c *Client) GetRoles(ctx context.Context) ([]Role, error) {
+	var roles []Role
+	err := c.listAll(ctx, "roles/", func(results json.RawMessage) error {
+		var page []Role
+		if err := json.Unmarshal(results, &page); err != nil {
+			return err
+		}
+
+		roles = append(roles, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
+
+func (c *Client) DeleteRole(ctx context.Context, id int) error {
+	err := c.performRequest(ctx, fmt.Sprintf("roles/%v/", id), http.MethodDelete, nil, nil)
 	return err
 }
 
-func (c *Client) UpdateRole(documentType Role) (*Role, error) {
+func (c *Client) UpdateRole(ctx context.Context, documentType Role) (*Role, error) {
 	var updatedRole *Role
-	err := c.performRequest(fmt.Sprintf("roles/%v/", documentType.ID), http.MethodPut, &documentType, &updatedRole)
+	err := c.performRequest(ctx, fmt.Sprintf("roles/%v/", documentType.ID), http.MethodPut, &documentType, &updatedRole)
 	if err != nil {
 		return &Role{}, err
 	}
@@ -45,33 +65,18 @@ func (c *Client) UpdateRole(documentType Role) (*Role, error) {
 	return updatedRole, nil
 }
 
-func (c *Client) GetRoleGroups(roleId int) ([]int, error) {
-	var results struct {
-		Results []struct {
-			ID int `json:"id"`
-		} `json:"results"`
-	}
-	err := c.performRequest(fmt.Sprintf("roles/%v/groups/?page_size=200", roleId), http.MethodGet, nil, &results)
-	if err != nil {
-		return nil, err
-	}
-
-	var ids []int
-	for _, result := range results.Results {
-		ids = append(ids, result.ID)
-	}
-
-	return ids, nil
+func (c *Client) GetRoleGroups(ctx context.Context, roleId int) ([]int, error) {
+	return c.listAllIds(ctx, fmt.Sprintf("roles/%v/groups/", roleId))
 }
 
-func (c *Client) AddRoleGroup(roleId int, groupId int) error {
+func (c *Client) AddRoleGroup(ctx context.Context, roleId int, groupId int) error {
 
 	var request struct {
 		GroupId int `json:"group_id"`
 	}
 	request.GroupId = groupId
 
-	err := c.performRequest(fmt.Sprintf("roles/%v/groups/add/", roleId), http.MethodPost, &request, nil)
+	err := c.performRequest(ctx, fmt.Sprintf("roles/%v/groups/add/", roleId), http.MethodPost, &request, nil)
 	if err != nil {
 		return err
 	}
@@ -79,14 +84,14 @@ func (c *Client) AddRoleGroup(roleId int, groupId int) error {
 	return nil
 }
 
-func (c *Client) RemoveRoleGroup(roleId int, groupId int) error {
+func (c *Client) RemoveRoleGroup(ctx context.Context, roleId int, groupId int) error {
 
 	var request struct {
 		GroupId int `json:"group_id"`
 	}
 	request.GroupId = groupId
 
-	err := c.performRequest(fmt.Sprintf("roles/%v/groups/remove/", roleId), http.MethodPost, &request, nil)
+	err := c.performRequest(ctx, fmt.Sprintf("roles/%v/groups/remove/", roleId), http.MethodPost, &request, nil)
 	if err != nil {
 		return err
 	}
@@ -94,33 +99,36 @@ func (c *Client) RemoveRoleGroup(roleId int, groupId int) error {
 	return nil
 }
 
-func (c *Client) GetRolePermissions(roleId int) ([]string, error) {
-	var results struct {
-		Results []struct {
+func (c *Client) GetRolePermissions(ctx context.Context, roleId int) ([]string, error) {
+	var ids []string
+	err := c.listAll(ctx, fmt.Sprintf("roles/%v/permissions/", roleId), func(results json.RawMessage) error {
+		var permissions []struct {
 			Pk string `json:"pk"`
-		} `json:"results"`
-	}
-	err := c.performRequest(fmt.Sprintf("roles/%v/permissions/?page_size=200", roleId), http.MethodGet, nil, &results)
+		}
+		if err := json.Unmarshal(results, &permissions); err != nil {
+			return err
+		}
+
+		for _, permission := range permissions {
+			ids = append(ids, permission.Pk)
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	var ids []string
-	for _, result := range results.Results {
-		ids = append(ids, result.Pk)
-	}
-
 	return ids, nil
 }
 
-func (c *Client) AddRolePermission(roleId int, permissionPk string) error {
+func (c *Client) AddRolePermission(ctx context.Context, roleId int, permissionPk string) error {
 
 	var request struct {
 		Permission string `json:"permission"`
 	}
 	request.Permission = permissionPk
 
-	err := c.performRequest(fmt.Sprintf("roles/%v/permissions/add/", roleId), http.MethodPost, &request, nil)
+	err := c.performRequest(ctx, fmt.Sprintf("roles/%v/permissions/add/", roleId), http.MethodPost, &request, nil)
 	if err != nil {
 		return err
 	}
@@ -128,14 +136,14 @@ func (c *Client) AddRolePermission(roleId int, permissionPk string) error {
 	return nil
 }
 
-func (c *Client) RemoveRolePermission(roleId int, permissionPk string) error {
+func (c *Client) RemoveRolePermission(ctx context.Context, roleId int, permissionPk string) error {
 
 	var request struct {
 		Permission string `json:"permission"`
 	}
 	request.Permission = permissionPk
 
-	err := c.performRequest(fmt.Sprintf("roles/%v/permissions/remove/", roleId), http.MethodPost, &request, nil)
+	err := c.performRequest(ctx, fmt.Sprintf("roles/%v/permissions/remove/", roleId), http.MethodPost, &request, nil)
 	if err != nil {
 		return err
 	}
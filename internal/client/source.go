@@ -1,6 +1,8 @@
 package client
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 )
@@ -13,9 +15,9 @@ type Source struct {
 	Enabled     bool   `json:"enabled"`
 }
 
-func (c *Client) CreateSource(source Source) (*Source, error) {
+func (c *Client) CreateSource(ctx context.Context, source Source) (*Source, error) {
 	var createdSource *Source
-	err := c.performRequest("sources/", http.MethodPost, &source, &createdSource)
+	err := c.performRequest(ctx, "sources/", http.MethodPost, &source, &createdSource)
 	if err != nil {
 		return &Source{}, err
 	}
@@ -23,9 +25,9 @@ func (c *Client) CreateSource(source Source) (*Source, error) {
 	return createdSource, nil
 }
 
-func (c *Client) GetSourceById(id int) (*Source, error) {
+func (c *Client) GetSourceById(ctx context.Context, id int) (*Source, error) {
 	var source *Source
-	err := c.performRequest(fmt.Sprintf("sources/%v/", id), http.MethodGet, nil, &source)
+	err := c.performRequest(ctx, fmt.Sprintf("sources/%v/", id), http.MethodGet, nil, &source)
 	if err != nil {
 		return &Source{}, err
 	}
@@ -33,14 +35,32 @@ func (c *Client) GetSourceById(id int) (*Source, error) {
 	return source, nil
 }
 
-func (c *Client) DeleteSource(id int) error {
-	err := c.performRequest(fmt.Sprintf("sources/%v/", id), http.MethodDelete, nil, nil)
+func (c *Client) GetSources(ctx context.Context) ([]Source, error) {
+	var sources []Source
+	err := c.listAll(ctx, "sources/", func(results json.RawMessage) error {
+		var page []Source
+		if err := json.Unmarshal(results, &page); err != nil {
+			return err
+		}
+
+		sources = append(sources, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sources, nil
+}
+
+func (c *Client) DeleteSource(ctx context.Context, id int) error {
+	err := c.performRequest(ctx, fmt.Sprintf("sources/%v/", id), http.MethodDelete, nil, nil)
 	return err
 }
 
-func (c *Client) UpdateSource(source Source) (*Source, error) {
+func (c *Client) UpdateSource(ctx context.Context, source Source) (*Source, error) {
 	var updatedSource *Source
-	err := c.performRequest(fmt.Sprintf("sources/%v/", source.ID), http.MethodPut, &source, &updatedSource)
+	err := c.performRequest(ctx, fmt.Sprintf("sources/%v/", source.ID), http.MethodPut, &source, &updatedSource)
 	if err != nil {
 		return &Source{}, err
 	}
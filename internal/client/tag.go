@@ -1,6 +1,8 @@
 package client
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 )
@@ -11,9 +13,9 @@ type Tag struct {
 	Color string `json:"color"`
 }
 
-func (c *Client) CreateTag(tag Tag) (*Tag, error) {
+func (c *Client) CreateTag(ctx context.Context, tag Tag) (*Tag, error) {
 	var createdDoc *Tag
-	err := c.performRequest("tags/", http.MethodPost, &tag, &createdDoc)
+	err := c.performRequest(ctx, "tags/", http.MethodPost, &tag, &createdDoc)
 	if err != nil {
 		return &Tag{}, err
 	}
@@ -21,9 +23,9 @@ func (c *Client) CreateTag(tag Tag) (*Tag, error) {
 	return createdDoc, nil
 }
 
-func (c *Client) GetTagById(id int) (*Tag, error) {
+func (c *Client) GetTagById(ctx context.Context, id int) (*Tag, error) {
 	var tag *Tag
-	err := c.performRequest(fmt.Sprintf("tags/%v/", id), http.MethodGet, nil, &tag)
+	err := c.performRequest(ctx, fmt.Sprintf("tags/%v/", id), http.MethodGet, nil, &tag)
 	if err != nil {
 		return &Tag{}, err
 	}
@@ -31,14 +33,32 @@ func (c *Client) GetTagById(id int) (*Tag, error) {
 	return tag, nil
 }
 
-func (c *Client) DeleteTag(id int) error {
-	err := c.performRequest(fmt.Sprintf("tags/%v/", id), http.MethodDelete, nil, nil)
+func (c *Client) GetTags(ctx context.Context) ([]Tag, error) {
+	var tags []Tag
+	err := c.listAll(ctx, "tags/", func(results json.RawMessage) error {
+		var page []Tag
+		if err := json.Unmarshal(results, &page); err != nil {
+			return err
+		}
+
+		tags = append(tags, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+func (c *Client) DeleteTag(ctx context.Context, id int) error {
+	err := c.performRequest(ctx, fmt.Sprintf("tags/%v/", id), http.MethodDelete, nil, nil)
 	return err
 }
 
-func (c *Client) UpdateTag(documentType Tag) (*Tag, error) {
+func (c *Client) UpdateTag(ctx context.Context, documentType Tag) (*Tag, error) {
 	var updatedTag *Tag
-	err := c.performRequest(fmt.Sprintf("tags/%v/", documentType.ID), http.MethodPut, &documentType, &updatedTag)
+	err := c.performRequest(ctx, fmt.Sprintf("tags/%v/", documentType.ID), http.MethodPut, &documentType, &updatedTag)
 	if err != nil {
 		return &Tag{}, err
 	}
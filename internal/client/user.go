@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type User struct {
+	ID        int    `json:"id"`
+	Username  string `json:"username"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Email     string `json:"email"`
+	IsActive  bool   `json:"is_active"`
+
+	// Password is only sent when set and is never returned by the API.
+	Password string `json:"password,omitempty"`
+}
+
+func (c *Client) CreateUser(ctx context.Context, user User) (*User, error) {
+	var createdUser *User
+	err := c.performRequest(ctx, "users/", http.MethodPost, &user, &createdUser)
+	if err != nil {
+		return &User{}, err
+	}
+
+	return createdUser, nil
+}
+
+func (c *Client) GetUserById(ctx context.Context, id int) (*User, error) {
+	var user *User
+	err := c.performRequest(ctx, fmt.Sprintf("users/%v/", id), http.MethodGet, nil, &user)
+	if err != nil {
+		return &User{}, err
+	}
+
+	return user, nil
+}
+
+func (c *Client) GetUsers(ctx context.Context) ([]User, error) {
+	var users []User
+	err := c.listAll(ctx, "users/", func(results json.RawMessage) error {
+		var page []User
+		if err := json.Unmarshal(results, &page); err != nil {
+			return err
+		}
+
+		users = append(users, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+func (c *Client) DeleteUser(ctx context.Context, id int) error {
+	err := c.performRequest(ctx, fmt.Sprintf("users/%v/", id), http.MethodDelete, nil, nil)
+	return err
+}
+
+func (c *Client) UpdateUser(ctx context.Context, user User) (*User, error) {
+	var updatedUser *User
+	err := c.performRequest(ctx, fmt.Sprintf("users/%v/", user.ID), http.MethodPut, &user, &updatedUser)
+	if err != nil {
+		return &User{}, err
+	}
+
+	return updatedUser, nil
+}
@@ -1,6 +1,8 @@
 package client
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 )
@@ -11,18 +13,9 @@ type WorkflowTemplate struct {
 	InternalName string `json:"internal_name"`
 }
 
-type WorkflowStateAction struct {
-	ID         int    `json:"id"`
-	ActionPath string `json:"action_path"`
-	ActionData string `json:"action_data"`
-	Enabled    bool   `json:"enabled"`
-	Label      string `json:"label"`
-	When       int    `json:"when"`
-}
-
-func (c *Client) CreateWorkflowTemplate(workflowTemplate WorkflowTemplate) (*WorkflowTemplate, error) {
+func (c *Client) CreateWorkflowTemplate(ctx context.Context, workflowTemplate WorkflowTemplate) (*WorkflowTemplate, error) {
 	var createdDoc *WorkflowTemplate
-	err := c.performRequest("workflow_templates/", http.MethodPost, &workflowTemplate, &createdDoc)
+	err := c.performRequest(ctx, "workflow_templates/", http.MethodPost, &workflowTemplate, &createdDoc)
 	if err != nil {
 		return &WorkflowTemplate{}, err
 	}
@@ -30,9 +23,9 @@ func (c *Client) CreateWorkflowTemplate(workflowTemplate WorkflowTemplate) (*Wor
 	return createdDoc, nil
 }
 
-func (c *Client) GetWorkflowTemplateById(id int) (*WorkflowTemplate, error) {
+func (c *Client) GetWorkflowTemplateById(ctx context.Context, id int) (*WorkflowTemplate, error) {
 	var workflowTemplate *WorkflowTemplate
-	err := c.performRequest(fmt.Sprintf("workflow_templates/%v/", id), http.MethodGet, nil, &workflowTemplate)
+	err := c.performRequest(ctx, fmt.Sprintf("workflow_templates/%v/", id), http.MethodGet, nil, &workflowTemplate)
 	if err != nil {
 		return &WorkflowTemplate{}, err
 	}
@@ -40,14 +33,32 @@ func (c *Client) GetWorkflowTemplateById(id int) (*WorkflowTemplate, error) {
 	return workflowTemplate, nil
 }
 
-func (c *Client) DeleteWorkflowTemplate(id int) error {
-	err := c.performRequest(fmt.Sprintf("workflow_templates/%v/", id), http.MethodDelete, nil, nil)
+func (c *Client) GetWorkflowTemplates(ctx context.Context) ([]WorkflowTemplate, error) {
+	var workflowTemplates []WorkflowTemplate
+	err := c.listAll(ctx, "workflow_templates/", func(results json.RawMessage) error {
+		var page []WorkflowTemplate
+		if err := json.Unmarshal(results, &page); err != nil {
+			return err
+		}
+
+		workflowTemplates = append(workflowTemplates, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return workflowTemplates, nil
+}
+
+func (c *Client) DeleteWorkflowTemplate(ctx context.Context, id int) error {
+	err := c.performRequest(ctx, fmt.Sprintf("workflow_templates/%v/", id), http.MethodDelete, nil, nil)
 	return err
 }
 
-func (c *Client) UpdateWorkflowTemplate(documentType WorkflowTemplate) (*WorkflowTemplate, error) {
+func (c *Client) UpdateWorkflowTemplate(ctx context.Context, documentType WorkflowTemplate) (*WorkflowTemplate, error) {
 	var updatedWorkflowTemplate *WorkflowTemplate
-	err := c.performRequest(fmt.Sprintf("workflow_templates/%v/", documentType.ID), http.MethodPut, &documentType, &updatedWorkflowTemplate)
+	err := c.performRequest(ctx, fmt.Sprintf("workflow_templates/%v/", documentType.ID), http.MethodPut, &documentType, &updatedWorkflowTemplate)
 	if err != nil {
 		return &WorkflowTemplate{}, err
 	}
@@ -55,33 +66,18 @@ func (c *Client) UpdateWorkflowTemplate(documentType WorkflowTemplate) (*Workflo
 	return updatedWorkflowTemplate, nil
 }
 
-func (c *Client) GetWorkflowIndexDocumentTypes(workflowTemplateId int) ([]int, error) {
-	var results struct {
-		Results []struct {
-			ID int `json:"id"`
-		} `json:"results"`
-	}
-	err := c.performRequest(fmt.Sprintf("workflow_templates/%v/document_types/", workflowTemplateId), http.MethodGet, nil, &results)
-	if err != nil {
-		return nil, err
-	}
-
-	var ids []int
-	for _, result := range results.Results {
-		ids = append(ids, result.ID)
-	}
-
-	return ids, nil
+func (c *Client) GetWorkflowIndexDocumentTypes(ctx context.Context, workflowTemplateId int) ([]int, error) {
+	return c.listAllIds(ctx, fmt.Sprintf("workflow_templates/%v/document_types/", workflowTemplateId))
 }
 
-func (c *Client) AddWorkflowIndexDocumentType(workflowTemplateId int, documentTypeId int) error {
+func (c *Client) AddWorkflowIndexDocumentType(ctx context.Context, workflowTemplateId int, documentTypeId int) error {
 
 	var request struct {
 		DocumentTypeId int `json:"document_type_id"`
 	}
 	request.DocumentTypeId = documentTypeId
 
-	err := c.performRequest(fmt.Sprintf("workflow_templates/%v/document_types/add/", workflowTemplateId), http.MethodPost, &request, nil)
+	err := c.performRequest(ctx, fmt.Sprintf("workflow_templates/%v/document_types/add/", workflowTemplateId), http.MethodPost, &request, nil)
 	if err != nil {
 		return err
 	}
@@ -89,14 +85,14 @@ func (c *Client) AddWorkflowIndexDocumentType(workflowTemplateId int, documentTy
 	return nil
 }
 
-func (c *Client) RemoveWorkflowIndexDocumentType(workflowTemplateId int, documentTypeId int) error {
+func (c *Client) RemoveWorkflowIndexDocumentType(ctx context.Context, workflowTemplateId int, documentTypeId int) error {
 
 	var request struct {
 		DocumentTypeId int `json:"document_type_id"`
 	}
 	request.DocumentTypeId = documentTypeId
 
-	err := c.performRequest(fmt.Sprintf("workflow_templates/%v/document_types/remove/", workflowTemplateId), http.MethodPost, &request, nil)
+	err := c.performRequest(ctx, fmt.Sprintf("workflow_templates/%v/document_types/remove/", workflowTemplateId), http.MethodPost, &request, nil)
 	if err != nil {
 		return err
 	}
@@ -1,6 +1,8 @@
 package client
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 )
@@ -12,9 +14,9 @@ type WorkflowTemplateState struct {
 	Label      string `json:"label"`
 }
 
-func (c *Client) GetWorkflowTemplateState(workflowTemplateId int, stateId int) (*WorkflowTemplateState, error) {
+func (c *Client) GetWorkflowTemplateState(ctx context.Context, workflowTemplateId int, stateId int) (*WorkflowTemplateState, error) {
 	var result WorkflowTemplateState
-	err := c.performRequest(fmt.Sprintf("workflow_templates/%v/states/%v/", workflowTemplateId, stateId), http.MethodGet, nil, &result)
+	err := c.performRequest(ctx, fmt.Sprintf("workflow_templates/%v/states/%v/", workflowTemplateId, stateId), http.MethodGet, nil, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -22,9 +24,27 @@ func (c *Client) GetWorkflowTemplateState(workflowTemplateId int, stateId int) (
 	return &result, nil
 }
 
-func (c *Client) CreateWorkflowTemplateState(workflowTemplateId int, state WorkflowTemplateState) (*WorkflowTemplateState, error) {
+func (c *Client) GetWorkflowTemplateStates(ctx context.Context, workflowTemplateId int) ([]WorkflowTemplateState, error) {
+	var states []WorkflowTemplateState
+	err := c.listAll(ctx, fmt.Sprintf("workflow_templates/%v/states/", workflowTemplateId), func(results json.RawMessage) error {
+		var page []WorkflowTemplateState
+		if err := json.Unmarshal(results, &page); err != nil {
+			return err
+		}
+
+		states = append(states, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return states, nil
+}
+
+func (c *Client) CreateWorkflowTemplateState(ctx context.Context, workflowTemplateId int, state WorkflowTemplateState) (*WorkflowTemplateState, error) {
 	var newState WorkflowTemplateState
-	err := c.performRequest(fmt.Sprintf("workflow_templates/%v/states/", workflowTemplateId), http.MethodPost, &state, &newState)
+	err := c.performRequest(ctx, fmt.Sprintf("workflow_templates/%v/states/", workflowTemplateId), http.MethodPost, &state, &newState)
 	if err != nil {
 		return &WorkflowTemplateState{}, err
 	}
@@ -32,9 +52,9 @@ func (c *Client) CreateWorkflowTemplateState(workflowTemplateId int, state Workf
 	return &newState, nil
 }
 
-func (c *Client) RemoveWorkflowTemplateState(workflowTemplateId int, stateId int) error {
+func (c *Client) RemoveWorkflowTemplateState(ctx context.Context, workflowTemplateId int, stateId int) error {
 
-	err := c.performRequest(fmt.Sprintf("workflow_templates/%v/states/%v/", workflowTemplateId, stateId), http.MethodDelete, nil, nil)
+	err := c.performRequest(ctx, fmt.Sprintf("workflow_templates/%v/states/%v/", workflowTemplateId, stateId), http.MethodDelete, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -42,9 +62,9 @@ func (c *Client) RemoveWorkflowTemplateState(workflowTemplateId int, stateId int
 	return nil
 }
 
-func (c *Client) UpdateWorkflowTemplateState(workflowTemplateId int, state WorkflowTemplateState) (*WorkflowTemplateState, error) {
+func (c *Client) UpdateWorkflowTemplateState(ctx context.Context, workflowTemplateId int, state WorkflowTemplateState) (*WorkflowTemplateState, error) {
 	var updatedState WorkflowTemplateState
-	err := c.performRequest(fmt.Sprintf("workflow_templates/%v/states/%v/", workflowTemplateId, state.ID), http.MethodPut, &state, &updatedState)
+	err := c.performRequest(ctx, fmt.Sprintf("workflow_templates/%v/states/%v/", workflowTemplateId, state.ID), http.MethodPut, &state, &updatedState)
 	if err != nil {
 		return &WorkflowTemplateState{}, err
 	}
@@ -0,0 +1,122 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	WorkflowStateActionOnEntry = 1
+	WorkflowStateActionOnExit  = 2
+)
+
+// WorkflowStateAction is an action executed when a document enters or leaves
+// a workflow state. ActionData holds the settings of the action backend named
+// by ActionPath; Mayan EDMS stores them as a JSON encoded string.
+type WorkflowStateAction struct {
+	ID         int                    `json:"id"`
+	ActionPath string                 `json:"action_path"`
+	ActionData map[string]interface{} `json:"action_data"`
+	Enabled    bool                   `json:"enabled"`
+	Label      string                 `json:"label"`
+	When       int                    `json:"when"`
+}
+
+type workflowStateAction WorkflowStateAction
+
+func (a WorkflowStateAction) MarshalJSON() ([]byte, error) {
+	actionData := a.ActionData
+	if actionData == nil {
+		actionData = map[string]interface{}{}
+	}
+
+	encoded, err := json.Marshal(actionData)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(struct {
+		workflowStateAction
+		ActionData string `json:"action_data"`
+	}{
+		workflowStateAction: workflowStateAction(a),
+		ActionData:          string(encoded),
+	})
+}
+
+// UnmarshalJSON reads action_data as sent by the API, a JSON encoded string,
+// and also accepts it as an object or null.
+func (a *WorkflowStateAction) UnmarshalJSON(data []byte) error {
+	var decoded struct {
+		*workflowStateAction
+		ActionData json.RawMessage `json:"action_data"`
+	}
+	decoded.workflowStateAction = (*workflowStateAction)(a)
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	a.ActionData = map[string]interface{}{}
+	actionData := []byte(decoded.ActionData)
+
+	var encoded string
+	if err := json.Unmarshal(actionData, &encoded); err == nil {
+		actionData = []byte(encoded)
+	}
+
+	if len(bytes.TrimSpace(actionData)) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(actionData, &a.ActionData); err != nil {
+		return fmt.Errorf("invalid action_data of workflow state action %v: %v", a.ID, err)
+	}
+	if a.ActionData == nil {
+		a.ActionData = map[string]interface{}{}
+	}
+
+	return nil
+}
+
+func (c *Client) GetWorkflowTemplateStateAction(ctx context.Context, workflowTemplateId int, stateId int, actionId int) (*WorkflowStateAction, error) {
+	var result WorkflowStateAction
+	err := c.performRequest(ctx, fmt.Sprintf("workflow_templates/%v/states/%v/actions/%v/", workflowTemplateId, stateId, actionId), http.MethodGet, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (c *Client) CreateWorkflowTemplateStateAction(ctx context.Context, workflowTemplateId int, stateId int, action WorkflowStateAction) (*WorkflowStateAction, error) {
+	var newAction WorkflowStateAction
+	err := c.performRequest(ctx, fmt.Sprintf("workflow_templates/%v/states/%v/actions/", workflowTemplateId, stateId), http.MethodPost, &action, &newAction)
+	if err != nil {
+		return &WorkflowStateAction{}, err
+	}
+
+	return &newAction, nil
+}
+
+func (c *Client) RemoveWorkflowTemplateStateAction(ctx context.Context, workflowTemplateId int, stateId int, actionId int) error {
+
+	err := c.performRequest(ctx, fmt.Sprintf("workflow_templates/%v/states/%v/actions/%v/", workflowTemplateId, stateId, actionId), http.MethodDelete, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) UpdateWorkflowTemplateStateAction(ctx context.Context, workflowTemplateId int, stateId int, action WorkflowStateAction) (*WorkflowStateAction, error) {
+	var updatedAction WorkflowStateAction
+	err := c.performRequest(ctx, fmt.Sprintf("workflow_templates/%v/states/%v/actions/%v/", workflowTemplateId, stateId, action.ID), http.MethodPut, &action, &updatedAction)
+	if err != nil {
+		return &WorkflowStateAction{}, err
+	}
+
+	return &updatedAction, nil
+}
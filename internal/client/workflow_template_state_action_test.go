@@ -0,0 +1,137 @@
+package client
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestWorkflowStateActionUnmarshal(t *testing.T) {
+	cases := []struct {
+		name       string
+		actionData string
+		expected   map[string]interface{}
+		err        bool
+	}{
+		{
+			name:       "string encoded",
+			actionData: `"{\"tags\": [1, 2]}"`,
+			expected:   map[string]interface{}{"tags": []interface{}{float64(1), float64(2)}},
+		},
+		{
+			name:       "object",
+			actionData: `{"tags": [1, 2]}`,
+			expected:   map[string]interface{}{"tags": []interface{}{float64(1), float64(2)}},
+		},
+		{
+			name:       "empty string",
+			actionData: `""`,
+			expected:   map[string]interface{}{},
+		},
+		{
+			name:       "empty object",
+			actionData: `{}`,
+			expected:   map[string]interface{}{},
+		},
+		{
+			name:       "null",
+			actionData: `null`,
+			expected:   map[string]interface{}{},
+		},
+		{
+			name:       "string encoded null",
+			actionData: `"null"`,
+			expected:   map[string]interface{}{},
+		},
+		{
+			name:       "invalid string",
+			actionData: `"{\"tags\": "`,
+			err:        true,
+		},
+		{
+			name:       "not an object",
+			actionData: `[1, 2]`,
+			err:        true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			body := `{"id": 3, "action_path": "mayan.apps.tags.workflow_actions.AttachTagAction", "action_data": ` + tc.actionData + `, "enabled": true, "label": "Tag", "when": 1}`
+
+			var action WorkflowStateAction
+			err := json.Unmarshal([]byte(body), &action)
+			if tc.err {
+				if err == nil {
+					t.Fatalf("expected an error, got %+v", action)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(action.ActionData, tc.expected) {
+				t.Errorf("expected action data %#v, got %#v", tc.expected, action.ActionData)
+			}
+			if action.ID != 3 || action.Label != "Tag" || !action.Enabled || action.When != WorkflowStateActionOnEntry {
+				t.Errorf("unexpected action %+v", action)
+			}
+		})
+	}
+}
+
+func TestWorkflowStateActionMarshal(t *testing.T) {
+	cases := []struct {
+		name       string
+		actionData map[string]interface{}
+		expected   string
+	}{
+		{
+			name:       "data",
+			actionData: map[string]interface{}{"tags": []int{1, 2}},
+			expected:   `{"tags":[1,2]}`,
+		},
+		{
+			name:       "empty",
+			actionData: map[string]interface{}{},
+			expected:   `{}`,
+		},
+		{
+			name:     "nil",
+			expected: `{}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			action := WorkflowStateAction{ID: 3, ActionPath: "path", ActionData: tc.actionData, Label: "Tag", When: WorkflowStateActionOnExit}
+
+			encoded, err := json.Marshal(action)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var fields map[string]interface{}
+			if err := json.Unmarshal(encoded, &fields); err != nil {
+				t.Fatalf("unable to decode %s: %v", encoded, err)
+			}
+			if fields["action_data"] != tc.expected {
+				t.Errorf("expected action_data to be the string %q, got %#v", tc.expected, fields["action_data"])
+			}
+			if fields["action_path"] != "path" || fields["label"] != "Tag" || fields["when"] != float64(WorkflowStateActionOnExit) {
+				t.Errorf("unexpected fields %v", fields)
+			}
+
+			var decoded WorkflowStateAction
+			if err := json.Unmarshal(encoded, &decoded); err != nil {
+				t.Fatalf("unable to read back %s: %v", encoded, err)
+			}
+			var expected map[string]interface{}
+			_ = json.Unmarshal([]byte(tc.expected), &expected)
+			if !reflect.DeepEqual(decoded.ActionData, expected) {
+				t.Errorf("expected round trip action data %#v, got %#v", expected, decoded.ActionData)
+			}
+		})
+	}
+}
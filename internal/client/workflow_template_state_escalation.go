@@ -0,0 +1,110 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// WorkflowStateEscalation executes a transition automatically once a document
+// has stayed in a state for Amount of Unit, such as 5 days.
+type WorkflowStateEscalation struct {
+	ID           int
+	TransitionID int
+	Enabled      bool
+	Priority     int
+	Amount       int
+	Unit         string
+	Condition    string
+	Comment      string
+}
+
+type workflowStateEscalation struct {
+	ID         int `json:"id"`
+	Transition struct {
+		ID int `json:"id"`
+	} `json:"transition"`
+	Enabled   bool   `json:"enabled"`
+	Priority  int    `json:"priority"`
+	Amount    int    `json:"amount"`
+	Unit      string `json:"unit"`
+	Condition string `json:"condition"`
+	Comment   string `json:"comment"`
+}
+
+type workflowStateEscalationRequest struct {
+	TransitionId int    `json:"transition_id"`
+	Enabled      bool   `json:"enabled"`
+	Priority     int    `json:"priority"`
+	Amount       int    `json:"amount"`
+	Unit         string `json:"unit"`
+	Condition    string `json:"condition"`
+	Comment      string `json:"comment"`
+}
+
+func (e workflowStateEscalation) toWorkflowStateEscalation() *WorkflowStateEscalation {
+	return &WorkflowStateEscalation{
+		ID:           e.ID,
+		TransitionID: e.Transition.ID,
+		Enabled:      e.Enabled,
+		Priority:     e.Priority,
+		Amount:       e.Amount,
+		Unit:         e.Unit,
+		Condition:    e.Condition,
+		Comment:      e.Comment,
+	}
+}
+
+func newWorkflowStateEscalationRequest(escalation WorkflowStateEscalation) workflowStateEscalationRequest {
+	return workflowStateEscalationRequest{
+		TransitionId: escalation.TransitionID,
+		Enabled:      escalation.Enabled,
+		Priority:     escalation.Priority,
+		Amount:       escalation.Amount,
+		Unit:         escalation.Unit,
+		Condition:    escalation.Condition,
+		Comment:      escalation.Comment,
+	}
+}
+
+func (c *Client) GetWorkflowTemplateStateEscalation(ctx context.Context, workflowTemplateId int, stateId int, escalationId int) (*WorkflowStateEscalation, error) {
+	var result workflowStateEscalation
+	err := c.performRequest(ctx, fmt.Sprintf("workflow_templates/%v/states/%v/escalations/%v/", workflowTemplateId, stateId, escalationId), http.MethodGet, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.toWorkflowStateEscalation(), nil
+}
+
+func (c *Client) CreateWorkflowTemplateStateEscalation(ctx context.Context, workflowTemplateId int, stateId int, escalation WorkflowStateEscalation) (*WorkflowStateEscalation, error) {
+	var newEscalation workflowStateEscalation
+	request := newWorkflowStateEscalationRequest(escalation)
+	err := c.performRequest(ctx, fmt.Sprintf("workflow_templates/%v/states/%v/escalations/", workflowTemplateId, stateId), http.MethodPost, &request, &newEscalation)
+	if err != nil {
+		return &WorkflowStateEscalation{}, err
+	}
+
+	return newEscalation.toWorkflowStateEscalation(), nil
+}
+
+func (c *Client) RemoveWorkflowTemplateStateEscalation(ctx context.Context, workflowTemplateId int, stateId int, escalationId int) error {
+
+	err := c.performRequest(ctx, fmt.Sprintf("workflow_templates/%v/states/%v/escalations/%v/", workflowTemplateId, stateId, escalationId), http.MethodDelete, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) UpdateWorkflowTemplateStateEscalation(ctx context.Context, workflowTemplateId int, stateId int, escalation WorkflowStateEscalation) (*WorkflowStateEscalation, error) {
+	var updatedEscalation workflowStateEscalation
+	request := newWorkflowStateEscalationRequest(escalation)
+	err := c.performRequest(ctx, fmt.Sprintf("workflow_templates/%v/states/%v/escalations/%v/", workflowTemplateId, stateId, escalation.ID), http.MethodPut, &request, &updatedEscalation)
+	if err != nil {
+		return &WorkflowStateEscalation{}, err
+	}
+
+	return updatedEscalation.toWorkflowStateEscalation(), nil
+}
@@ -1,6 +1,8 @@
 package client
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 )
@@ -21,9 +23,9 @@ type workflowTemplateTransition struct {
 	OriginStateId      int    `json:"origin_state_id"`
 }
 
-func (c *Client) GetWorkflowTemplateTransition(workflowTemplateId int, transitionId int) (*WorkflowTemplateTransition, error) {
+func (c *Client) GetWorkflowTemplateTransition(ctx context.Context, workflowTemplateId int, transitionId int) (*WorkflowTemplateTransition, error) {
 	var result WorkflowTemplateTransition
-	err := c.performRequest(fmt.Sprintf("workflow_templates/%v/transitions/%v/", workflowTemplateId, transitionId), http.MethodGet, nil, &result)
+	err := c.performRequest(ctx, fmt.Sprintf("workflow_templates/%v/transitions/%v/", workflowTemplateId, transitionId), http.MethodGet, nil, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -31,7 +33,25 @@ func (c *Client) GetWorkflowTemplateTransition(workflowTemplateId int, transitio
 	return &result, nil
 }
 
-func (c *Client) CreateWorkflowTemplateTransition(workflowTemplateId int, transition WorkflowTemplateTransition) (*WorkflowTemplateTransition, error) {
+func (c *Client) GetWorkflowTemplateTransitions(ctx context.Context, workflowTemplateId int) ([]WorkflowTemplateTransition, error) {
+	var transitions []WorkflowTemplateTransition
+	err := c.listAll(ctx, fmt.Sprintf("workflow_templates/%v/transitions/", workflowTemplateId), func(results json.RawMessage) error {
+		var page []WorkflowTemplateTransition
+		if err := json.Unmarshal(results, &page); err != nil {
+			return err
+		}
+
+		transitions = append(transitions, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transitions, nil
+}
+
+func (c *Client) CreateWorkflowTemplateTransition(ctx context.Context, workflowTemplateId int, transition WorkflowTemplateTransition) (*WorkflowTemplateTransition, error) {
 	var newTransition WorkflowTemplateTransition
 	request := workflowTemplateTransition{
 		Label:              transition.Label,
@@ -39,7 +59,7 @@ func (c *Client) CreateWorkflowTemplateTransition(workflowTemplateId int, transi
 		DestinationStateId: transition.DestinationState.ID,
 		OriginStateId:      transition.OriginState.ID,
 	}
-	err := c.performRequest(fmt.Sprintf("workflow_templates/%v/transitions/", workflowTemplateId), http.MethodPost, &request, &newTransition)
+	err := c.performRequest(ctx, fmt.Sprintf("workflow_templates/%v/transitions/", workflowTemplateId), http.MethodPost, &request, &newTransition)
 	if err != nil {
 		return &WorkflowTemplateTransition{}, err
 	}
@@ -47,9 +67,9 @@ func (c *Client) CreateWorkflowTemplateTransition(workflowTemplateId int, transi
 	return &newTransition, nil
 }
 
-func (c *Client) RemoveWorkflowTemplateTransition(workflowTemplateId int, transitionId int) error {
+func (c *Client) RemoveWorkflowTemplateTransition(ctx context.Context, workflowTemplateId int, transitionId int) error {
 
-	err := c.performRequest(fmt.Sprintf("workflow_templates/%v/transitions/%v/", workflowTemplateId, transitionId), http.MethodDelete, nil, nil)
+	err := c.performRequest(ctx, fmt.Sprintf("workflow_templates/%v/transitions/%v/", workflowTemplateId, transitionId), http.MethodDelete, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -57,7 +77,7 @@ func (c *Client) RemoveWorkflowTemplateTransition(workflowTemplateId int, transi
 	return nil
 }
 
-func (c *Client) UpdateWorkflowTemplateTransition(workflowTemplateId int, transition WorkflowTemplateTransition) (*WorkflowTemplateTransition, error) {
+func (c *Client) UpdateWorkflowTemplateTransition(ctx context.Context, workflowTemplateId int, transition WorkflowTemplateTransition) (*WorkflowTemplateTransition, error) {
 	var updatedTransition WorkflowTemplateTransition
 	request := workflowTemplateTransition{
 		Label:              transition.Label,
@@ -65,7 +85,7 @@ func (c *Client) UpdateWorkflowTemplateTransition(workflowTemplateId int, transi
 		DestinationStateId: transition.DestinationState.ID,
 		OriginStateId:      transition.OriginState.ID,
 	}
-	err := c.performRequest(fmt.Sprintf("workflow_templates/%v/transitions/%v/", workflowTemplateId, transition.ID), http.MethodPut, &request, &updatedTransition)
+	err := c.performRequest(ctx, fmt.Sprintf("workflow_templates/%v/transitions/%v/", workflowTemplateId, transition.ID), http.MethodPut, &request, &updatedTransition)
 	if err != nil {
 		return &WorkflowTemplateTransition{}, err
 	}
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	WorkflowTransitionFieldTypeCharacter = 1
+	WorkflowTransitionFieldTypeInteger   = 2
+
+	WorkflowTransitionFieldWidgetTextArea = 1
+)
+
+// WorkflowTransitionField is an extra value the user is asked for when
+// executing a transition. The value is stored with the transition log entry.
+type WorkflowTransitionField struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	Label        string `json:"label"`
+	FieldType    int    `json:"field_type"`
+	HelpText     string `json:"help_text"`
+	Required     bool   `json:"required"`
+	Widget       *int   `json:"widget"`
+	WidgetKwargs string `json:"widget_kwargs"`
+	Lookup       string `json:"lookup"`
+}
+
+func (c *Client) GetWorkflowTemplateTransitionField(ctx context.Context, workflowTemplateId int, transitionId int, fieldId int) (*WorkflowTransitionField, error) {
+	var result WorkflowTransitionField
+	err := c.performRequest(ctx, fmt.Sprintf("workflow_templates/%v/transitions/%v/fields/%v/", workflowTemplateId, transitionId, fieldId), http.MethodGet, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (c *Client) CreateWorkflowTemplateTransitionField(ctx context.Context, workflowTemplateId int, transitionId int, field WorkflowTransitionField) (*WorkflowTransitionField, error) {
+	var newField WorkflowTransitionField
+	err := c.performRequest(ctx, fmt.Sprintf("workflow_templates/%v/transitions/%v/fields/", workflowTemplateId, transitionId), http.MethodPost, &field, &newField)
+	if err != nil {
+		return &WorkflowTransitionField{}, err
+	}
+
+	return &newField, nil
+}
+
+func (c *Client) RemoveWorkflowTemplateTransitionField(ctx context.Context, workflowTemplateId int, transitionId int, fieldId int) error {
+
+	err := c.performRequest(ctx, fmt.Sprintf("workflow_templates/%v/transitions/%v/fields/%v/", workflowTemplateId, transitionId, fieldId), http.MethodDelete, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) UpdateWorkflowTemplateTransitionField(ctx context.Context, workflowTemplateId int, transitionId int, field WorkflowTransitionField) (*WorkflowTransitionField, error) {
+	var updatedField WorkflowTransitionField
+	err := c.performRequest(ctx, fmt.Sprintf("workflow_templates/%v/transitions/%v/fields/%v/", workflowTemplateId, transitionId, field.ID), http.MethodPut, &field, &updatedField)
+	if err != nil {
+		return &WorkflowTransitionField{}, err
+	}
+
+	return &updatedField, nil
+}
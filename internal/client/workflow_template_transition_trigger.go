@@ -0,0 +1,63 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// WorkflowTransitionTrigger fires a transition automatically when an event,
+// such as "documents.document_create", is committed on a document.
+type WorkflowTransitionTrigger struct {
+	ID        int
+	EventType string
+}
+
+type workflowTransitionTrigger struct {
+	ID        int `json:"id"`
+	EventType struct {
+		ID string `json:"id"`
+	} `json:"event_type"`
+}
+
+func (t workflowTransitionTrigger) toWorkflowTransitionTrigger() *WorkflowTransitionTrigger {
+	return &WorkflowTransitionTrigger{
+		ID:        t.ID,
+		EventType: t.EventType.ID,
+	}
+}
+
+func (c *Client) GetWorkflowTemplateTransitionTrigger(ctx context.Context, workflowTemplateId int, transitionId int, triggerId int) (*WorkflowTransitionTrigger, error) {
+	var result workflowTransitionTrigger
+	err := c.performRequest(ctx, fmt.Sprintf("workflow_templates/%v/transitions/%v/triggers/%v/", workflowTemplateId, transitionId, triggerId), http.MethodGet, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.toWorkflowTransitionTrigger(), nil
+}
+
+func (c *Client) CreateWorkflowTemplateTransitionTrigger(ctx context.Context, workflowTemplateId int, transitionId int, trigger WorkflowTransitionTrigger) (*WorkflowTransitionTrigger, error) {
+	var newTrigger workflowTransitionTrigger
+	request := struct {
+		EventTypeId string `json:"event_type_id"`
+	}{
+		EventTypeId: trigger.EventType,
+	}
+	err := c.performRequest(ctx, fmt.Sprintf("workflow_templates/%v/transitions/%v/triggers/", workflowTemplateId, transitionId), http.MethodPost, &request, &newTrigger)
+	if err != nil {
+		return &WorkflowTransitionTrigger{}, err
+	}
+
+	return newTrigger.toWorkflowTransitionTrigger(), nil
+}
+
+func (c *Client) RemoveWorkflowTemplateTransitionTrigger(ctx context.Context, workflowTemplateId int, transitionId int, triggerId int) error {
+
+	err := c.performRequest(ctx, fmt.Sprintf("workflow_templates/%v/transitions/%v/triggers/%v/", workflowTemplateId, transitionId, triggerId), http.MethodDelete, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceSchemaFromResource copies the schema of a resource with every
+// attribute computed, so data sources can reuse the resource *ToData mappers.
+// The data source is looked up either by id or by one of the given keys.
+func dataSourceSchemaFromResource(r *schema.Resource, lookupKeys ...string) map[string]*schema.Schema {
+	exactlyOneOf := append([]string{"id"}, lookupKeys...)
+
+	dataSourceSchema := map[string]*schema.Schema{
+		"id": {
+			Description:  "Id of the object to look up.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ExactlyOneOf: exactlyOneOf,
+		},
+	}
+
+	for name, s := range r.Schema {
+		dataSourceSchema[name] = &schema.Schema{
+			Description: s.Description,
+			Type:        s.Type,
+			Computed:    true,
+			Sensitive:   s.Sensitive,
+			Elem:        s.Elem,
+		}
+	}
+
+	for _, key := range lookupKeys {
+		dataSourceSchema[key].Optional = true
+		dataSourceSchema[key].ExactlyOneOf = exactlyOneOf
+	}
+
+	return dataSourceSchema
+}
+
+// dataSourceId returns the numeric id configured on a data source, if any.
+func dataSourceId(d *schema.ResourceData) (int, bool, error) {
+	value, ok := d.GetOk("id")
+	if !ok {
+		return 0, false, nil
+	}
+
+	id, err := strconv.Atoi(value.(string))
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid id %q: %v", value, err)
+	}
+
+	return id, true, nil
+}
+
+// uniqueMatch returns the index of the only candidate whose key equals value.
+func uniqueMatch(kind string, attribute string, value string, count int, key func(i int) string) (int, error) {
+	match := -1
+	for i := 0; i < count; i++ {
+		if key(i) != value {
+			continue
+		}
+
+		if match >= 0 {
+			return 0, fmt.Errorf("more than one %v found with %v %q", kind, attribute, value)
+		}
+		match = i
+	}
+
+	if match < 0 {
+		return 0, fmt.Errorf("no %v found with %v %q", kind, attribute, value)
+	}
+
+	return match, nil
+}
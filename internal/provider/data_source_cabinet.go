@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rfleming71/terraform-provider-mayan-edms/client"
+)
+
+func dataSourceCabinet() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCabinetRead,
+
+		Schema: dataSourceSchemaFromResource(resourceCabinet(), "full_path"),
+	}
+}
+
+func dataSourceCabinetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+
+	cabinet, err := findCabinet(ctx, c, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	path, lookup := d.GetOk("full_path")
+	if err := cabinetToData(cabinet, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Keep the path as it was written, spacing around the separators included.
+	if lookup {
+		return diag.FromErr(d.Set("full_path", path))
+	}
+
+	return nil
+}
+
+func findCabinet(ctx context.Context, c client.MayanEdmsClient, d *schema.ResourceData) (*client.Cabinet, error) {
+	id, ok, err := dataSourceId(d)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return c.GetCabinetById(ctx, id)
+	}
+
+	cabinets, err := c.GetCabinets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	value := cabinetPath(d.Get("full_path").(string))
+	i, err := uniqueMatch("cabinet", "full_path", value, len(cabinets), func(i int) string {
+		return cabinetPath(cabinets[i].FullPath)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &cabinets[i], nil
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rfleming71/terraform-provider-mayan-edms/client"
+)
+
+func dataSourceDocumentType() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDocumentTypeRead,
+
+		Schema: dataSourceSchemaFromResource(resourceDocumentType(), "label"),
+	}
+}
+
+func dataSourceDocumentTypeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+
+	documentType, err := findDocumentType(ctx, c, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(documentTypeToData(documentType, d))
+}
+
+func findDocumentType(ctx context.Context, c client.MayanEdmsClient, d *schema.ResourceData) (*client.DocumentType, error) {
+	id, ok, err := dataSourceId(d)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return c.GetDocumentTypeById(ctx, id)
+	}
+
+	documentTypes, err := c.GetDocumentTypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	value := d.Get("label").(string)
+	i, err := uniqueMatch("document type", "label", value, len(documentTypes), func(i int) string {
+		return documentTypes[i].Label
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &documentTypes[i], nil
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rfleming71/terraform-provider-mayan-edms/client"
+)
+
+func dataSourceGroup() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGroupRead,
+
+		Schema: dataSourceSchemaFromResource(resourceGroup(), "name"),
+	}
+}
+
+func dataSourceGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+
+	group, err := findGroup(ctx, c, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	userIds, err := c.GetGroupUsers(ctx, group.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("users", userIds); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(groupToData(group, d))
+}
+
+func findGroup(ctx context.Context, c client.MayanEdmsClient, d *schema.ResourceData) (*client.Group, error) {
+	id, ok, err := dataSourceId(d)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return c.GetGroupById(ctx, id)
+	}
+
+	groups, err := c.GetGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	value := d.Get("name").(string)
+	i, err := uniqueMatch("group", "name", value, len(groups), func(i int) string {
+		return groups[i].Name
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &groups[i], nil
+}
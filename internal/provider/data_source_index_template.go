@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rfleming71/terraform-provider-mayan-edms/client"
+)
+
+func dataSourceIndexTemplate() *schema.Resource {
+	indexTemplateSchema := dataSourceSchemaFromResource(resourceIndexTemplate(), "slug")
+	delete(indexTemplateSchema, "rebuild_on_change")
+
+	return &schema.Resource{
+		ReadContext: dataSourceIndexTemplateRead,
+
+		Schema: indexTemplateSchema,
+	}
+}
+
+func dataSourceIndexTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+
+	indexTemplate, err := findIndexTemplate(ctx, c, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	docTypes, err := c.GetIndexTemplateDocumentTypes(ctx, indexTemplate.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("document_types", docTypes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(indexTemplateToData(indexTemplate, d))
+}
+
+func findIndexTemplate(ctx context.Context, c client.MayanEdmsClient, d *schema.ResourceData) (*client.IndexTemplate, error) {
+	id, ok, err := dataSourceId(d)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return c.GetIndexTemplateById(ctx, id)
+	}
+
+	indexTemplates, err := c.GetIndexTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	value := d.Get("slug").(string)
+	i, err := uniqueMatch("index template", "slug", value, len(indexTemplates), func(i int) string {
+		return indexTemplates[i].Slug
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &indexTemplates[i], nil
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rfleming71/terraform-provider-mayan-edms/client"
+)
+
+func dataSourceMetadataType() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMetadataTypeRead,
+
+		Schema: dataSourceSchemaFromResource(resourceMetadataType(), "name"),
+	}
+}
+
+func dataSourceMetadataTypeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+
+	metadataType, err := findMetadataType(ctx, c, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(metadataTypeToData(metadataType, d))
+}
+
+func findMetadataType(ctx context.Context, c client.MayanEdmsClient, d *schema.ResourceData) (*client.MetadataType, error) {
+	id, ok, err := dataSourceId(d)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return c.GetMetadataTypeById(ctx, id)
+	}
+
+	metadataTypes, err := c.GetMetadataTypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	value := d.Get("name").(string)
+	i, err := uniqueMatch("metadata type", "name", value, len(metadataTypes), func(i int) string {
+		return metadataTypes[i].Name
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &metadataTypes[i], nil
+}
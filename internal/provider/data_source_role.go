@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rfleming71/terraform-provider-mayan-edms/client"
+)
+
+func dataSourceRole() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRoleRead,
+
+		Schema: dataSourceSchemaFromResource(resourceRole(), "label"),
+	}
+}
+
+func dataSourceRoleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+
+	role, err := findRole(ctx, c, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	groups, err := c.GetRoleGroups(ctx, role.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("groups", groups); err != nil {
+		return diag.FromErr(err)
+	}
+
+	permissions, err := c.GetRolePermissions(ctx, role.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("permissions", permissions); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(roleToData(role, d))
+}
+
+func findRole(ctx context.Context, c client.MayanEdmsClient, d *schema.ResourceData) (*client.Role, error) {
+	id, ok, err := dataSourceId(d)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return c.GetRoleById(ctx, id)
+	}
+
+	roles, err := c.GetRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	value := d.Get("label").(string)
+	i, err := uniqueMatch("role", "label", value, len(roles), func(i int) string {
+		return roles[i].Label
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &roles[i], nil
+}
@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rfleming71/terraform-provider-mayan-edms/client"
+)
+
+func dataSourceSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description:  "Id of the source to look up.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "label"},
+			},
+			"label": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "label"},
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"backend_path": {
+				Description: "Python path of the source backend, which identifies the kind of source.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"backend_data": {
+				Description: "JSON encoded settings of the source backend. The password of email sources is left out.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func dataSourceSourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+
+	source, err := findSource(ctx, c, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(sourceToData(source, d))
+}
+
+func findSource(ctx context.Context, c client.MayanEdmsClient, d *schema.ResourceData) (*client.Source, error) {
+	id, ok, err := dataSourceId(d)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return c.GetSourceById(ctx, id)
+	}
+
+	sources, err := c.GetSources(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	value := d.Get("label").(string)
+	i, err := uniqueMatch("source", "label", value, len(sources), func(i int) string {
+		return sources[i].Label
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &sources[i], nil
+}
+
+func sourceToData(source *client.Source, d *schema.ResourceData) error {
+	d.SetId(fmt.Sprintf("%v", source.ID))
+	if err := d.Set("label", source.Label); err != nil {
+		return err
+	}
+	if err := d.Set("enabled", source.Enabled); err != nil {
+		return err
+	}
+	if err := d.Set("backend_path", source.BackendPath); err != nil {
+		return err
+	}
+	if err := d.Set("backend_data", redactBackendData(source.BackendData)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// redactBackendData removes the password of email sources from the backend
+// settings, so it isn't written to the state of every configuration reading
+// the source.
+func redactBackendData(backendData string) string {
+	var settings map[string]interface{}
+	if err := json.Unmarshal([]byte(backendData), &settings); err != nil {
+		return backendData
+	}
+	if _, ok := settings["password"]; !ok {
+		return backendData
+	}
+
+	delete(settings, "password")
+	redacted, err := json.Marshal(settings)
+	if err != nil {
+		return ""
+	}
+
+	return string(redacted)
+}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rfleming71/terraform-provider-mayan-edms/client"
+)
+
+// fakeSourceClient serves a single source.
+type fakeSourceClient struct {
+	client.MayanEdmsClient
+	source client.Source
+}
+
+func (c *fakeSourceClient) GetSourceById(ctx context.Context, id int) (*client.Source, error) {
+	source := c.source
+	return &source, nil
+}
+
+func (c *fakeSourceClient) GetSources(ctx context.Context) ([]client.Source, error) {
+	return []client.Source{c.source}, nil
+}
+
+func TestDataSourceSourceHidesPassword(t *testing.T) {
+	if !dataSourceSource().Schema["backend_data"].Sensitive {
+		t.Error("expected backend_data to be sensitive")
+	}
+
+	c := &fakeSourceClient{source: client.Source{
+		ID:          4,
+		Label:       "Invoices",
+		BackendPath: "mayan.apps.sources.source_backends.email_backends.SourceBackendIMAPEmail",
+		BackendData: `{"host": "imap.example.com", "port": 993, "username": "invoices", "password": "s3cr3t"}`,
+		Enabled:     true,
+	}}
+
+	for _, raw := range []map[string]interface{}{{"id": "4"}, {"label": "Invoices"}} {
+		d := schema.TestResourceDataRaw(t, dataSourceSource().Schema, raw)
+		if diags := dataSourceSourceRead(context.Background(), d, c); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+
+		state := d.State()
+		for key, value := range state.Attributes {
+			if strings.Contains(value, "s3cr3t") {
+				t.Errorf("expected the password to be left out of the state, found it in %v: %v", key, value)
+			}
+		}
+		if backendData := d.Get("backend_data").(string); !strings.Contains(backendData, `"host":"imap.example.com"`) {
+			t.Errorf("expected the other settings to be kept, got %v", backendData)
+		}
+	}
+}
+
+func TestRedactBackendData(t *testing.T) {
+	cases := map[string]string{
+		`{"path": "/srv/watch"}`:              `{"path": "/srv/watch"}`,
+		`{"password": "s3cr3t", "port": 110}`: `{"port":110}`,
+		`{"password": ""}`:                    `{}`,
+		`not json`:                            `not json`,
+		``:                                    ``,
+	}
+
+	for backendData, expected := range cases {
+		if actual := redactBackendData(backendData); actual != expected {
+			t.Errorf("%v: expected %v, got %v", backendData, expected, actual)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rfleming71/terraform-provider-mayan-edms/client"
+)
+
+func dataSourceTag() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceTagRead,
+
+		Schema: dataSourceSchemaFromResource(resourceTag(), "label"),
+	}
+}
+
+func dataSourceTagRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+
+	tag, err := findTag(ctx, c, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(tagToData(tag, d))
+}
+
+func findTag(ctx context.Context, c client.MayanEdmsClient, d *schema.ResourceData) (*client.Tag, error) {
+	id, ok, err := dataSourceId(d)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return c.GetTagById(ctx, id)
+	}
+
+	tags, err := c.GetTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	value := d.Get("label").(string)
+	i, err := uniqueMatch("tag", "label", value, len(tags), func(i int) string {
+		return tags[i].Label
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &tags[i], nil
+}
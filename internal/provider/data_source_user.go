@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rfleming71/terraform-provider-mayan-edms/client"
+)
+
+func dataSourceUser() *schema.Resource {
+	userSchema := dataSourceSchemaFromResource(resourceUser(), "username")
+	delete(userSchema, "password")
+
+	return &schema.Resource{
+		ReadContext: dataSourceUserRead,
+
+		Schema: userSchema,
+	}
+}
+
+func dataSourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+
+	user, err := findUser(ctx, c, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(userToData(user, d))
+}
+
+func findUser(ctx context.Context, c client.MayanEdmsClient, d *schema.ResourceData) (*client.User, error) {
+	id, ok, err := dataSourceId(d)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return c.GetUserById(ctx, id)
+	}
+
+	users, err := c.GetUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	value := d.Get("username").(string)
+	i, err := uniqueMatch("user", "username", value, len(users), func(i int) string {
+		return users[i].Username
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &users[i], nil
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rfleming71/terraform-provider-mayan-edms/client"
+)
+
+func dataSourceWorkflowTemplate() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceWorkflowTemplateRead,
+
+		Schema: dataSourceSchemaFromResource(resourceWorkflowTemplate(), "internal_name"),
+	}
+}
+
+func dataSourceWorkflowTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+
+	workflowTemplate, err := findWorkflowTemplate(ctx, c, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	docTypes, err := c.GetWorkflowIndexDocumentTypes(ctx, workflowTemplate.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("document_types", docTypes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(workflowTemplateToData(workflowTemplate, d))
+}
+
+func findWorkflowTemplate(ctx context.Context, c client.MayanEdmsClient, d *schema.ResourceData) (*client.WorkflowTemplate, error) {
+	id, ok, err := dataSourceId(d)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return c.GetWorkflowTemplateById(ctx, id)
+	}
+
+	workflowTemplates, err := c.GetWorkflowTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	value := d.Get("internal_name").(string)
+	i, err := uniqueMatch("workflow template", "internal_name", value, len(workflowTemplates), func(i int) string {
+		return workflowTemplates[i].InternalName
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &workflowTemplates[i], nil
+}
@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rfleming71/terraform-provider-mayan-edms/client"
+)
+
+func dataSourceWorkflowTemplateGraph() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceWorkflowTemplateGraphRead,
+
+		Schema: map[string]*schema.Schema{
+			"workflow_template": {
+				Description: "Id of the workflow template to draw.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+			"dot": {
+				Description: "The states and transitions of the workflow as a Graphviz DOT digraph.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"mermaid": {
+				Description: "The states and transitions of the workflow as a Mermaid state diagram.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"warnings": {
+				Description: "States that cannot be reached from the initial state, or that cannot be left although they don't complete the workflow.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceWorkflowTemplateGraphRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	workflowTemplateId := d.Get("workflow_template").(int)
+
+	workflowTemplate, err := c.GetWorkflowTemplateById(ctx, workflowTemplateId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	states, err := c.GetWorkflowTemplateStates(ctx, workflowTemplateId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	transitions, err := c.GetWorkflowTemplateTransitions(ctx, workflowTemplateId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sort.Slice(states, func(i, j int) bool { return states[i].ID < states[j].ID })
+	sort.Slice(transitions, func(i, j int) bool { return transitions[i].ID < transitions[j].ID })
+
+	d.SetId(fmt.Sprintf("%v", workflowTemplate.ID))
+	if err := d.Set("dot", workflowGraphDot(workflowTemplate, states, transitions)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("mermaid", workflowGraphMermaid(states, transitions)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	stateLabels := map[int]string{}
+	for _, state := range states {
+		stateLabels[state.ID] = state.Label
+	}
+
+	labelled := make([]workflowTransition, 0, len(transitions))
+	for _, transition := range transitions {
+		labelled = append(labelled, workflowTransition{
+			label:            transition.Label,
+			originState:      stateLabels[transition.OriginState.ID],
+			destinationState: stateLabels[transition.DestinationState.ID],
+			condition:        transition.Condition,
+		})
+	}
+
+	if err := d.Set("warnings", workflowGraphWarnings(states, labelled)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// workflowGraphDot draws the initial state with a double border and labels
+// each state with its completion.
+func workflowGraphDot(workflowTemplate *client.WorkflowTemplate, states []client.WorkflowTemplateState, transitions []client.WorkflowTemplateTransition) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %v {\n", dotQuote(workflowTemplate.Label))
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box, style=rounded];\n")
+	for _, state := range states {
+		attributes := fmt.Sprintf("label=%v", dotQuote(fmt.Sprintf("%v\n%v%%", state.Label, state.Completion)))
+		if state.Initial {
+			attributes += ", peripheries=2"
+		}
+		fmt.Fprintf(&b, "  s%v [%v];\n", state.ID, attributes)
+	}
+
+	for _, transition := range transitions {
+		label := transition.Label
+		if transition.Condition != "" {
+			label += "\n[" + transition.Condition + "]"
+		}
+		fmt.Fprintf(&b, "  s%v -> s%v [label=%v];\n", transition.OriginState.ID, transition.DestinationState.ID, dotQuote(label))
+	}
+	b.WriteString("}\n")
+
+	return b.String()
+}
+
+// workflowGraphMermaid draws the initial state as the target of the start
+// pseudo state and labels each state with its completion.
+func workflowGraphMermaid(states []client.WorkflowTemplateState, transitions []client.WorkflowTemplateTransition) string {
+	var b strings.Builder
+	b.WriteString("stateDiagram-v2\n")
+	for _, state := range states {
+		fmt.Fprintf(&b, "  state \"%v (%v%%)\" as s%v\n", mermaidEscape(state.Label), state.Completion, state.ID)
+	}
+
+	for _, state := range states {
+		if state.Initial {
+			fmt.Fprintf(&b, "  [*] --> s%v\n", state.ID)
+		}
+	}
+
+	for _, transition := range transitions {
+		label := transition.Label
+		if transition.Condition != "" {
+			label += " [" + transition.Condition + "]"
+		}
+		fmt.Fprintf(&b, "  s%v --> s%v: %v\n", transition.OriginState.ID, transition.DestinationState.ID, mermaidEscape(label))
+	}
+
+	return b.String()
+}
+
+func dotQuote(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	return `"` + replacer.Replace(value) + `"`
+}
+
+// mermaidEscape replaces the characters that end a Mermaid statement or label
+// with their entity codes.
+func mermaidEscape(value string) string {
+	replacer := strings.NewReplacer(`"`, "#quot;", ";", "#59;", "\n", " ")
+	return replacer.Replace(value)
+}
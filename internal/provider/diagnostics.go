@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rfleming71/terraform-provider-mayan-edms/client"
+)
+
+// apiErrorDiagnostics converts an error returned by the client into
+// diagnostics. Validation errors that Mayan reports against a field are
+// attached to the attribute of the same name when the resource configures it.
+func apiErrorDiagnostics(d *schema.ResourceData, summary string, err error) diag.Diagnostics {
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) || len(apiErr.FieldErrors) == 0 {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  summary,
+			Detail:   err.Error(),
+		}}
+	}
+
+	fields := make([]string, 0, len(apiErr.FieldErrors))
+	for field := range apiErr.FieldErrors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	var diags diag.Diagnostics
+	for _, field := range fields {
+		detail := fmt.Sprintf("%v: %v", field, apiErr.FieldErrors[field])
+		var path cty.Path
+		if hasAttribute(d, field) {
+			path = cty.GetAttrPath(field)
+			detail = fmt.Sprint(apiErr.FieldErrors[field])
+		}
+
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       summary,
+			Detail:        detail,
+			AttributePath: path,
+		})
+	}
+
+	if apiErr.Detail != "" {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  summary,
+			Detail:   apiErr.Detail,
+		})
+	}
+
+	return diags
+}
+
+// attributeDiagnostic builds a diagnostic for an error that relates to a
+// single attribute of the resource.
+func attributeDiagnostic(severity diag.Severity, attribute string, summary string, err error) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity:      severity,
+		Summary:       summary,
+		Detail:        err.Error(),
+		AttributePath: cty.GetAttrPath(attribute),
+	}
+}
+
+func hasAttribute(d *schema.ResourceData, attribute string) bool {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.Type().IsObjectType() {
+		return false
+	}
+
+	return config.Type().HasAttribute(attribute)
+}
@@ -0,0 +1,326 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// djangoTemplateBlockTags maps the block tags Mayan EDMS templates can use to
+// their end tag.
+var djangoTemplateBlockTags = map[string]string{
+	"autoescape":     "endautoescape",
+	"block":          "endblock",
+	"blocktrans":     "endblocktrans",
+	"blocktranslate": "endblocktranslate",
+	"comment":        "endcomment",
+	"filter":         "endfilter",
+	"for":            "endfor",
+	"if":             "endif",
+	"ifchanged":      "endifchanged",
+	"spaceless":      "endspaceless",
+	"verbatim":       "endverbatim",
+	"with":           "endwith",
+	"method":         "",
+	"regex_findall":  "",
+	"regex_match":    "",
+	"regex_search":   "",
+	"regex_sub":      "",
+	"set":            "",
+	"cycle":          "",
+	"csrf_token":     "",
+	"debug":          "",
+	"extends":        "",
+	"firstof":        "",
+	"include":        "",
+	"load":           "",
+	"lorem":          "",
+	"now":            "",
+	"regroup":        "",
+	"resetcycle":     "",
+	"templatetag":    "",
+	"trans":          "",
+	"translate":      "",
+	"url":            "",
+	"widthratio":     "",
+}
+
+// djangoTemplateIntermediateTags lists the block tags each intermediate tag
+// can appear in.
+var djangoTemplateIntermediateTags = map[string][]string{
+	"elif":   {"if"},
+	"else":   {"if", "for", "ifchanged"},
+	"empty":  {"for"},
+	"plural": {"blocktrans", "blocktranslate"},
+}
+
+// djangoTemplateFilters lists the built-in Django filters and the ones Mayan
+// EDMS adds to every template.
+var djangoTemplateFilters = map[string]bool{
+	"add": true, "addslashes": true, "capfirst": true, "center": true, "cut": true,
+	"date": true, "default": true, "default_if_none": true, "dictsort": true,
+	"dictsortreversed": true, "divisibleby": true, "escape": true, "escapejs": true,
+	"escapeseq": true, "filesizeformat": true, "first": true, "floatformat": true,
+	"force_escape": true, "get_digit": true, "iriencode": true, "join": true,
+	"json_script": true, "last": true, "length": true, "length_is": true,
+	"linebreaks": true, "linebreaksbr": true, "linenumbers": true, "ljust": true,
+	"lower": true, "make_list": true, "phone2numeric": true, "pluralize": true,
+	"pprint": true, "random": true, "rjust": true, "safe": true, "safeseq": true,
+	"slice": true, "slugify": true, "stringformat": true, "striptags": true,
+	"time": true, "timesince": true, "timeuntil": true, "title": true,
+	"truncatechars": true, "truncatechars_html": true, "truncatewords": true,
+	"truncatewords_html": true, "unordered_list": true, "upper": true,
+	"urlencode": true, "urlize": true, "urlizetrunc": true, "wordcount": true,
+	"wordwrap": true, "yesno": true,
+	"date_parse": true, "dict_get": true, "split": true, "timedelta": true,
+}
+
+var djangoTemplateOpening = regexp.MustCompile(`\{[{%#]`)
+
+// djangoTemplateToken is a tag, variable or comment of a template, with the
+// text between its delimiters.
+type djangoTemplateToken struct {
+	delimiter string
+	content   string
+	position  int
+}
+
+// djangoTemplateRawEnd returns the end tag of a verbatim or comment block,
+// whose content is text, or "" for other tags.
+func djangoTemplateRawEnd(content string) string {
+	fields := strings.Fields(content)
+	if len(fields) == 0 {
+		return ""
+	}
+
+	switch fields[0] {
+	case "verbatim":
+		// A named verbatim block only ends with the same name.
+		return "end" + content
+	case "comment":
+		return "endcomment"
+	}
+
+	return ""
+}
+
+// tokenizeDjangoTemplate splits a template into its tags, variables and
+// comments. As in Django, the content of verbatim and comment blocks and
+// delimiters that are never closed are text; the latter are reported as
+// warnings as they are usually a mistake.
+func tokenizeDjangoTemplate(template string) ([]djangoTemplateToken, []string) {
+	closing := map[string]string{"{{": "}}", "{%": "%}", "{#": "#}"}
+
+	var tokens []djangoTemplateToken
+	var warnings []string
+	rawEnd := ""
+	position := 0
+	for {
+		match := djangoTemplateOpening.FindStringIndex(template[position:])
+		if match == nil {
+			break
+		}
+
+		start := position + match[0]
+		delimiter := template[start : start+2]
+		end := strings.Index(template[start+2:], closing[delimiter])
+		content := ""
+		if end != -1 {
+			content = strings.TrimSpace(template[start+2 : start+2+end])
+		}
+
+		if rawEnd != "" {
+			if end == -1 || delimiter != "{%" || content != rawEnd {
+				position = start + 2
+				continue
+			}
+			rawEnd = ""
+		} else if end == -1 {
+			warnings = append(warnings, fmt.Sprintf("%q at position %v is never closed with %q and is rendered as text", delimiter, start, closing[delimiter]))
+			position = start + 2
+			continue
+		} else if delimiter == "{%" {
+			rawEnd = djangoTemplateRawEnd(content)
+		}
+
+		tokens = append(tokens, djangoTemplateToken{
+			delimiter: delimiter,
+			content:   content,
+			position:  start,
+		})
+		position = start + 2 + end + 2
+	}
+
+	return tokens, warnings
+}
+
+// validateDjangoTemplate checks the syntax of a template without evaluating
+// it: block tags must be balanced and properly nested. Tags and filters that
+// are not known are returned as warnings, as they may come from apps
+// installed on the server. Templates loading a tag library are only checked
+// for balance, as the tags and filters it adds are not known.
+func validateDjangoTemplate(template string) ([]string, error) {
+	tokens, warnings := tokenizeDjangoTemplate(template)
+
+	checkNames := true
+	for _, token := range tokens {
+		if token.delimiter == "{%" && strings.HasPrefix(token.content, "load ") {
+			checkNames = false
+		}
+	}
+
+	type openTag struct {
+		name     string
+		position int
+	}
+	var stack []openTag
+	for _, token := range tokens {
+		if token.delimiter == "{#" {
+			continue
+		}
+
+		if token.content == "" {
+			return warnings, fmt.Errorf("empty %q at position %v", token.delimiter, token.position)
+		}
+
+		if token.delimiter == "{{" {
+			filterWarnings, err := validateDjangoTemplateFilters(token.content, token.position, checkNames)
+			warnings = append(warnings, filterWarnings...)
+			if err != nil {
+				return warnings, err
+			}
+			continue
+		}
+
+		name := strings.Fields(token.content)[0]
+		arguments := strings.TrimSpace(strings.TrimPrefix(token.content, name))
+
+		if parents, ok := djangoTemplateIntermediateTags[name]; ok {
+			inside := false
+			for _, parent := range parents {
+				inside = inside || len(stack) > 0 && stack[len(stack)-1].name == parent
+			}
+			if !inside {
+				return warnings, fmt.Errorf("{%% %v %%} at position %v is only allowed inside %v", name, token.position, strings.Join(parents, ", "))
+			}
+		} else if end, ok := djangoTemplateBlockTags[name]; ok {
+			if end != "" {
+				stack = append(stack, openTag{name: name, position: token.position})
+			}
+		} else if strings.HasPrefix(name, "end") {
+			if len(stack) == 0 {
+				return warnings, fmt.Errorf("{%% %v %%} at position %v has no matching start tag", name, token.position)
+			}
+			top := stack[len(stack)-1]
+			if djangoTemplateBlockTags[top.name] != name {
+				return warnings, fmt.Errorf("{%% %v %%} at position %v closes {%% %v %%} opened at position %v, expected {%% %v %%}", name, token.position, top.name, top.position, djangoTemplateBlockTags[top.name])
+			}
+			stack = stack[:len(stack)-1]
+			continue
+		} else if checkNames {
+			warnings = append(warnings, fmt.Sprintf("unknown tag {%% %v %%} at position %v", name, token.position))
+		}
+
+		var filterWarnings []string
+		var err error
+		switch name {
+		case "load", "verbatim", "comment", "templatetag":
+		case "filter":
+			filterWarnings, err = validateDjangoTemplateFilters("|"+arguments, token.position, checkNames)
+		default:
+			filterWarnings, err = validateDjangoTemplateFilters(arguments, token.position, checkNames)
+		}
+		warnings = append(warnings, filterWarnings...)
+		if err != nil {
+			return warnings, err
+		}
+	}
+
+	if len(stack) > 0 {
+		top := stack[len(stack)-1]
+		return warnings, fmt.Errorf("{%% %v %%} at position %v is never closed with {%% %v %%}", top.name, top.position, djangoTemplateBlockTags[top.name])
+	}
+
+	return warnings, nil
+}
+
+// validateDjangoTemplateFilters checks the filters applied in an expression,
+// skipping the content of string literals.
+func validateDjangoTemplateFilters(expression string, position int, checkNames bool) ([]string, error) {
+	var warnings []string
+	var quote rune
+	for i, r := range expression {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		case r == '|':
+			name := strings.TrimLeft(expression[i+1:], " ")
+			end := strings.IndexFunc(name, func(r rune) bool {
+				return !(r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+			})
+			if end != -1 {
+				name = name[:end]
+			}
+			if name == "" {
+				return warnings, fmt.Errorf("missing filter name after %q at position %v", "|", position)
+			}
+			if checkNames && !djangoTemplateFilters[name] {
+				warnings = append(warnings, fmt.Sprintf("unknown filter %q at position %v", name, position))
+			}
+		}
+	}
+
+	if quote != 0 {
+		return warnings, fmt.Errorf("string at position %v is never closed with %q", position, string(quote))
+	}
+
+	return warnings, nil
+}
+
+// validateDjangoTemplateString is a schema ValidateDiagFunc for attributes
+// holding a Django template. Syntax errors fail the plan, anything that may
+// still render is reported as a warning.
+func validateDjangoTemplateString(i interface{}, path cty.Path) diag.Diagnostics {
+	value, ok := i.(string)
+	if !ok {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Expected type to be string",
+			AttributePath: path,
+		}}
+	}
+
+	warnings, err := validateDjangoTemplate(value)
+
+	var diags diag.Diagnostics
+	for _, warning := range warnings {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       "Template may not render as expected",
+			Detail:        djangoTemplateSentence(warning),
+			AttributePath: path,
+		})
+	}
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid template",
+			Detail:        djangoTemplateSentence(err.Error()),
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
+
+// djangoTemplateSentence turns a message into a sentence for a diagnostic.
+func djangoTemplateSentence(message string) string {
+	return strings.ToUpper(message[:1]) + message[1:] + "."
+}
@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestValidateDjangoTemplate(t *testing.T) {
+	cases := []struct {
+		name     string
+		template string
+		err      string
+		warnings []string
+	}{
+		{
+			name:     "plain text",
+			template: "Invoices",
+		},
+		{
+			name:     "variable with filters",
+			template: "{{ document.date_added|date:\"Y\"|upper }}",
+		},
+		{
+			name:     "metadata value",
+			template: "{{ document.metadata_value_of.invoice_year }}",
+		},
+		{
+			name:     "if elif else",
+			template: "{% if document.label %}a{% elif document.uuid %}b{% else %}c{% endif %}",
+		},
+		{
+			name:     "for empty",
+			template: "{% for page in document.pages.all %}{{ page }}{% empty %}none{% endfor %}",
+		},
+		{
+			name:     "filter in string literal",
+			template: "{{ document.label|default:\"a|b\" }}",
+		},
+		{
+			name:     "comment",
+			template: "{# note #}{{ document.label }}",
+		},
+		{
+			name:     "verbatim",
+			template: "{% verbatim %}{{ {% endverbatim %}",
+		},
+		{
+			name:     "named verbatim",
+			template: "{% verbatim x %}{% endverbatim %}{% endverbatim x %}",
+		},
+		{
+			name:     "comment block",
+			template: "{% comment \"draft\" %}{% if %}{{ {% endcomment %}{{ document.label }}",
+		},
+		{
+			name:     "literal tag end",
+			template: "100%}",
+		},
+		{
+			name:     "mayan tags",
+			template: "{% method document \"get_label\" as label %}{% set label as x %}{{ x|split:\",\"|dict_get:0 }}",
+		},
+		{
+			name:     "load disables name checks",
+			template: "{% load custom %}{% custom_tag %}{{ x|custom_filter }}",
+		},
+		{
+			name:     "unknown tag",
+			template: "{% custom_tag document %}",
+			warnings: []string{"unknown tag {% custom_tag %} at position 0"},
+		},
+		{
+			name:     "unknown filter",
+			template: "{{ document.label|custom_filter }}",
+			warnings: []string{"unknown filter \"custom_filter\" at position 0"},
+		},
+		{
+			name:     "unclosed variable",
+			template: "{{ document.label",
+			warnings: []string{"\"{{\" at position 0 is never closed with \"}}\" and is rendered as text"},
+		},
+		{
+			name:     "unclosed block",
+			template: "{% if document.label %}a",
+			err:      "{% if %} at position 0 is never closed with {% endif %}",
+		},
+		{
+			name:     "unclosed verbatim",
+			template: "{% verbatim x %}{% endverbatim %}",
+			err:      "{% verbatim %} at position 0 is never closed with {% endverbatim %}",
+		},
+		{
+			name:     "mismatched end",
+			template: "{% for x in y %}{% endif %}",
+			err:      "{% endif %} at position 16 closes {% for %} opened at position 0, expected {% endfor %}",
+		},
+		{
+			name:     "end without start",
+			template: "a{% endif %}",
+			err:      "{% endif %} at position 1 has no matching start tag",
+		},
+		{
+			name:     "intermediate outside block",
+			template: "{% else %}",
+			err:      "{% else %} at position 0 is only allowed inside if, for, ifchanged",
+		},
+		{
+			name:     "empty variable",
+			template: "{{ }}",
+			err:      "empty \"{{\" at position 0",
+		},
+		{
+			name:     "missing filter name",
+			template: "{{ document.label| }}",
+			err:      "missing filter name after \"|\" at position 0",
+		},
+		{
+			name:     "unclosed string",
+			template: "{{ document.label|default:\"a }}",
+			err:      "string at position 0 is never closed with \"\\\"\"",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			warnings, err := validateDjangoTemplate(tc.template)
+
+			if tc.err == "" && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tc.err != "" && (err == nil || err.Error() != tc.err) {
+				t.Fatalf("expected error %q, got %v", tc.err, err)
+			}
+
+			if strings.Join(warnings, "\n") != strings.Join(tc.warnings, "\n") {
+				t.Fatalf("expected warnings %q, got %q", tc.warnings, warnings)
+			}
+		})
+	}
+}
+
+func TestValidateDjangoTemplateString(t *testing.T) {
+	cases := []struct {
+		name     string
+		value    interface{}
+		severity []diag.Severity
+	}{
+		{
+			name:  "valid",
+			value: "{{ document.label }}",
+		},
+		{
+			name:     "unknown tag",
+			value:    "{% custom_tag %}",
+			severity: []diag.Severity{diag.Warning},
+		},
+		{
+			name:     "unknown filter and unclosed block",
+			value:    "{% if x|custom_filter %}",
+			severity: []diag.Severity{diag.Warning, diag.Error},
+		},
+		{
+			name:     "not a string",
+			value:    1,
+			severity: []diag.Severity{diag.Error},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := validateDjangoTemplateString(tc.value, cty.GetAttrPath("expression"))
+
+			if len(diags) != len(tc.severity) {
+				t.Fatalf("expected %v diagnostics, got %v", len(tc.severity), diags)
+			}
+			for i, d := range diags {
+				if d.Severity != tc.severity[i] {
+					t.Errorf("diagnostic %v: expected severity %v, got %v: %v", i, tc.severity[i], d.Severity, d.Detail)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// emailSourceBackendDataType holds the backend data shared by the IMAP and
+// POP3 email sources.
+type emailSourceBackendDataType struct {
+	Host                   string `json:"host"`
+	SSL                    bool   `json:"ssl"`
+	Port                   int    `json:"port"`
+	Username               string `json:"username"`
+	Password               string `json:"password"`
+	DocumentTypeId         int    `json:"document_type_id"`
+	Interval               int    `json:"interval"`
+	Uncompress             string `json:"uncompress"`
+	MetadataAttachmentName string `json:"metadata_attachment_name"`
+	SubjectMetadataTypeId  *int   `json:"subject_metadata_type_id,omitempty"`
+	FromMetadataTypeId     *int   `json:"from_metadata_type_id,omitempty"`
+	StoreBody              bool   `json:"store_body"`
+}
+
+// emailSourceSchema returns the attributes shared by the IMAP and POP3 email
+// sources, with the port used when none is set.
+func emailSourceSchema(defaultPort int) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"label": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"enabled": {
+			Type:     schema.TypeBool,
+			Default:  true,
+			Optional: true,
+		},
+		"host": {
+			Description: "Host name or IP address of the mail server.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"ssl": {
+			Description: "Connect to the mail server over SSL.",
+			Type:        schema.TypeBool,
+			Default:     true,
+			Optional:    true,
+		},
+		"port": {
+			Description:  "Port of the mail server.",
+			Type:         schema.TypeInt,
+			Default:      defaultPort,
+			Optional:     true,
+			ValidateFunc: validation.IsPortNumber,
+		},
+		"username": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"password": {
+			Type:      schema.TypeString,
+			Required:  true,
+			Sensitive: true,
+		},
+		"document_type_id": {
+			Description: "Id of the document type assigned to the documents received.",
+			Type:        schema.TypeInt,
+			Required:    true,
+		},
+		"interval": {
+			Description: "Interval in seconds between checks for new messages.",
+			Type:        schema.TypeInt,
+			Required:    true,
+		},
+		"uncompress": {
+			Type:         schema.TypeString,
+			Default:      "ask",
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"ask", "yes", "no"}, false),
+		},
+		"metadata_attachment_name": {
+			Description: "Name of the attachment holding the metadata type names and values to assign to the other attachments of the message.",
+			Type:        schema.TypeString,
+			Default:     "metadata.yaml",
+			Optional:    true,
+		},
+		"subject_metadata_type_id": {
+			Description: "Id of the metadata type in which to store the subject of the message.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+		},
+		"from_metadata_type_id": {
+			Description: "Id of the metadata type in which to store the sender of the message.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+		},
+		"store_body": {
+			Description: "Store the body of the message as a text document.",
+			Type:        schema.TypeBool,
+			Default:     true,
+			Optional:    true,
+		},
+	}
+}
+
+func emailSourceToData(backendData emailSourceBackendDataType, d *schema.ResourceData) error {
+	if err := d.Set("host", backendData.Host); err != nil {
+		return err
+	}
+	if err := d.Set("ssl", backendData.SSL); err != nil {
+		return err
+	}
+	if err := d.Set("port", backendData.Port); err != nil {
+		return err
+	}
+	if err := d.Set("username", backendData.Username); err != nil {
+		return err
+	}
+
+	// Keep the configured password when the server doesn't disclose it.
+	if backendData.Password != "" {
+		if err := d.Set("password", backendData.Password); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("document_type_id", backendData.DocumentTypeId); err != nil {
+		return err
+	}
+	if err := d.Set("interval", backendData.Interval); err != nil {
+		return err
+	}
+	if err := d.Set("uncompress", uncompressedMapping[backendData.Uncompress]); err != nil {
+		return err
+	}
+	if err := d.Set("metadata_attachment_name", backendData.MetadataAttachmentName); err != nil {
+		return err
+	}
+
+	subjectMetadataTypeId := 0
+	if backendData.SubjectMetadataTypeId != nil {
+		subjectMetadataTypeId = *backendData.SubjectMetadataTypeId
+	}
+	if err := d.Set("subject_metadata_type_id", subjectMetadataTypeId); err != nil {
+		return err
+	}
+
+	fromMetadataTypeId := 0
+	if backendData.FromMetadataTypeId != nil {
+		fromMetadataTypeId = *backendData.FromMetadataTypeId
+	}
+	if err := d.Set("from_metadata_type_id", fromMetadataTypeId); err != nil {
+		return err
+	}
+
+	if err := d.Set("store_body", backendData.StoreBody); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func dataToEmailSourceBackendData(d *schema.ResourceData) emailSourceBackendDataType {
+	backendData := emailSourceBackendDataType{
+		Host:                   d.Get("host").(string),
+		SSL:                    d.Get("ssl").(bool),
+		Port:                   d.Get("port").(int),
+		Username:               d.Get("username").(string),
+		Password:               d.Get("password").(string),
+		DocumentTypeId:         d.Get("document_type_id").(int),
+		Interval:               d.Get("interval").(int),
+		Uncompress:             strings.ToLower(string(d.Get("uncompress").(string)[:1])),
+		MetadataAttachmentName: d.Get("metadata_attachment_name").(string),
+		StoreBody:              d.Get("store_body").(bool),
+	}
+
+	if id := d.Get("subject_metadata_type_id").(int); id != 0 {
+		backendData.SubjectMetadataTypeId = &id
+	}
+	if id := d.Get("from_metadata_type_id").(int); id != 0 {
+		backendData.FromMetadataTypeId = &id
+	}
+
+	return backendData
+}
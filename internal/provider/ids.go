@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func getIdInformation(d *schema.ResourceData) (int, int, error) {
+	return breakCompositeId(d.Id())
+}
+
+func breakCompositeId(id string) (int, int, error) {
+	ids := strings.Split(id, "-")
+	part1, err := strconv.Atoi(ids[0])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	part2, err := strconv.Atoi(ids[1])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return part1, part2, nil
+
+}
+
+// breakCompositeIds splits an id made of count numeric parts joined by "-",
+// such as "<workflow_template>-<state>-<action>".
+func breakCompositeIds(id string, count int) ([]int, error) {
+	parts := strings.Split(id, "-")
+	if len(parts) != count {
+		return nil, fmt.Errorf("unexpected id %q, expected %v parts separated by \"-\"", id, count)
+	}
+
+	ids := make([]int, count)
+	for i, part := range parts {
+		var err error
+		ids[i], err = strconv.Atoi(part)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return ids, nil
+}
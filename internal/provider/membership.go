@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// membership describes a set attribute whose members are attached to and
+// detached from an object one at a time, such as the groups of a role.
+type membership struct {
+	attribute string
+	member    string
+	owner     string
+	add       func(member interface{}) error
+	remove    func(member interface{}) error
+}
+
+// reconcileMembership adds and removes members until current matches desired.
+// Every item is attempted even when an earlier one fails, and all failures are
+// reported together in a single diagnostic against the attribute.
+func reconcileMembership(current *schema.Set, desired *schema.Set, m membership) diag.Diagnostics {
+	removals := current.Difference(desired)
+	additions := desired.Difference(current)
+
+	var failures []string
+	for _, removal := range removals.List() {
+		if err := m.remove(removal); err != nil {
+			failures = append(failures, fmt.Sprintf("remove %v %v: %v", m.member, removal, err))
+		}
+	}
+
+	for _, addition := range additions.List() {
+		if err := m.add(addition); err != nil {
+			failures = append(failures, fmt.Sprintf("add %v %v: %v", m.member, addition, err))
+		}
+	}
+
+	if len(failures) == 0 {
+		return nil
+	}
+
+	return diag.Diagnostics{{
+		Severity:      diag.Error,
+		Summary:       fmt.Sprintf("Unable to update %v of %v", m.attribute, m.owner),
+		Detail:        fmt.Sprintf("%v of %v changes failed:\n\n%v", len(failures), removals.Len()+additions.Len(), strings.Join(failures, "\n")),
+		AttributePath: cty.GetAttrPath(m.attribute),
+	}}
+}
+
+// reconcileMembershipChange reconciles a set attribute from its prior state to
+// its configured value.
+func reconcileMembershipChange(d *schema.ResourceData, m membership) diag.Diagnostics {
+	if !d.HasChange(m.attribute) {
+		return nil
+	}
+
+	o, n := d.GetChange(m.attribute)
+	return reconcileMembership(o.(*schema.Set), n.(*schema.Set), m)
+}
+
+// reconcileNewMembership attaches every configured member of a set attribute
+// to a newly created object.
+func reconcileNewMembership(d *schema.ResourceData, m membership) diag.Diagnostics {
+	desired := d.Get(m.attribute).(*schema.Set)
+	return reconcileMembership(schema.NewSet(desired.F, nil), desired, m)
+}
@@ -0,0 +1,325 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/rfleming71/terraform-provider-mayan-edms/client"
+)
+
+// fakeMembers records the members added and removed through a membership and
+// fails for the members listed in failing.
+type fakeMembers struct {
+	members map[int]bool
+	failing map[int]bool
+	added   []int
+	removed []int
+}
+
+func newFakeMembers(members []int, failing ...int) *fakeMembers {
+	f := &fakeMembers{members: map[int]bool{}, failing: map[int]bool{}}
+	for _, member := range members {
+		f.members[member] = true
+	}
+	for _, member := range failing {
+		f.failing[member] = true
+	}
+
+	return f
+}
+
+func (f *fakeMembers) membership() membership {
+	return membership{
+		attribute: "users",
+		member:    "user",
+		owner:     "group",
+		add: func(member interface{}) error {
+			id := member.(int)
+			f.added = append(f.added, id)
+			if f.failing[id] {
+				return errors.New("permission denied")
+			}
+			f.members[id] = true
+			return nil
+		},
+		remove: func(member interface{}) error {
+			id := member.(int)
+			f.removed = append(f.removed, id)
+			if f.failing[id] {
+				return errors.New("permission denied")
+			}
+			delete(f.members, id)
+			return nil
+		},
+	}
+}
+
+func (f *fakeMembers) list() []int {
+	members := []int{}
+	for member := range f.members {
+		members = append(members, member)
+	}
+	sort.Ints(members)
+
+	return members
+}
+
+func intSet(values ...int) *schema.Set {
+	items := make([]interface{}, len(values))
+	for i, value := range values {
+		items[i] = value
+	}
+
+	return schema.NewSet(schema.HashInt, items)
+}
+
+func sortedInts(values []int) []int {
+	sorted := append([]int{}, values...)
+	sort.Ints(sorted)
+
+	return sorted
+}
+
+func TestReconcileMembership(t *testing.T) {
+	cases := []struct {
+		name     string
+		current  []int
+		desired  []int
+		failing  []int
+		added    []int
+		removed  []int
+		members  []int
+		failures string
+	}{
+		{
+			name:    "no changes",
+			current: []int{1, 2},
+			desired: []int{2, 1},
+			members: []int{1, 2},
+		},
+		{
+			name:    "additions and removals",
+			current: []int{1, 2, 3},
+			desired: []int{2, 4, 5},
+			added:   []int{4, 5},
+			removed: []int{1, 3},
+			members: []int{2, 4, 5},
+		},
+		{
+			name:    "from empty",
+			desired: []int{1, 2},
+			added:   []int{1, 2},
+			members: []int{1, 2},
+		},
+		{
+			name:    "to empty",
+			current: []int{1, 2},
+			removed: []int{1, 2},
+			members: []int{},
+		},
+		{
+			name:     "partial failure",
+			current:  []int{1, 2},
+			desired:  []int{2, 3, 4},
+			failing:  []int{1, 3},
+			added:    []int{3, 4},
+			removed:  []int{1},
+			members:  []int{1, 2, 4},
+			failures: "2 of 3 changes failed",
+		},
+		{
+			name:     "every change fails",
+			current:  []int{1},
+			desired:  []int{2},
+			failing:  []int{1, 2},
+			added:    []int{2},
+			removed:  []int{1},
+			members:  []int{1},
+			failures: "2 of 2 changes failed",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newFakeMembers(tc.current, tc.failing...)
+
+			diags := reconcileMembership(intSet(tc.current...), intSet(tc.desired...), f.membership())
+
+			if !reflect.DeepEqual(sortedInts(f.added), sortedInts(tc.added)) {
+				t.Errorf("expected additions %v, got %v", tc.added, f.added)
+			}
+			if !reflect.DeepEqual(sortedInts(f.removed), sortedInts(tc.removed)) {
+				t.Errorf("expected removals %v, got %v", tc.removed, f.removed)
+			}
+			if !reflect.DeepEqual(f.list(), sortedInts(tc.members)) {
+				t.Errorf("expected members %v, got %v", tc.members, f.list())
+			}
+
+			if tc.failures == "" {
+				if diags.HasError() {
+					t.Fatalf("unexpected diagnostics: %v", diags)
+				}
+				return
+			}
+
+			if len(diags) != 1 || diags[0].Severity != diag.Error {
+				t.Fatalf("expected a single error, got %v", diags)
+			}
+			if !strings.HasPrefix(diags[0].Detail, tc.failures) {
+				t.Errorf("expected detail to start with %q, got %q", tc.failures, diags[0].Detail)
+			}
+			for _, member := range tc.failing {
+				if !strings.Contains(diags[0].Detail, fmt.Sprintf(" user %v: permission denied", member)) {
+					t.Errorf("expected detail to mention user %v, got %q", member, diags[0].Detail)
+				}
+			}
+			if !diags[0].AttributePath.Equals(cty.GetAttrPath("users")) {
+				t.Errorf("expected the diagnostic to point at users, got %#v", diags[0].AttributePath)
+			}
+		})
+	}
+}
+
+// groupConfig returns the raw configuration of a group with the given users.
+func groupConfig(users []int) map[string]interface{} {
+	raw := map[string]interface{}{"name": "accounting"}
+	if len(users) > 0 {
+		items := make([]interface{}, len(users))
+		for i, user := range users {
+			items[i] = user
+		}
+		raw["users"] = items
+	}
+
+	return raw
+}
+
+// groupData returns the data of a group planned from a state holding the
+// current users to a configuration holding the desired ones.
+func groupData(t *testing.T, current []int, desired []int) *schema.ResourceData {
+	t.Helper()
+
+	state := schema.TestResourceDataRaw(t, resourceGroup().Schema, groupConfig(current))
+	state.SetId("7")
+
+	sm := schema.InternalMap(resourceGroup().Schema)
+	diff, err := sm.Diff(context.Background(), state.State(), terraform.NewResourceConfigRaw(groupConfig(desired)), nil, nil, true)
+	if err != nil {
+		t.Fatalf("unable to plan: %v", err)
+	}
+
+	d, err := sm.Data(state.State(), diff)
+	if err != nil {
+		t.Fatalf("unable to read plan: %v", err)
+	}
+
+	return d
+}
+
+func TestReconcileMembershipChange(t *testing.T) {
+	cases := []struct {
+		name    string
+		current []int
+		desired []int
+		added   []int
+		removed []int
+	}{
+		{name: "unchanged", current: []int{1, 2}, desired: []int{2, 1}},
+		{name: "changed", current: []int{1, 2}, desired: []int{2, 3}, added: []int{3}, removed: []int{1}},
+		{name: "cleared", current: []int{1}, removed: []int{1}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := groupData(t, tc.current, tc.desired)
+			f := newFakeMembers(tc.current)
+			diags := reconcileMembershipChange(d, f.membership())
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if !reflect.DeepEqual(sortedInts(f.added), sortedInts(tc.added)) {
+				t.Errorf("expected additions %v, got %v", tc.added, f.added)
+			}
+			if !reflect.DeepEqual(sortedInts(f.removed), sortedInts(tc.removed)) {
+				t.Errorf("expected removals %v, got %v", tc.removed, f.removed)
+			}
+		})
+	}
+}
+
+func TestReconcileNewMembership(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceGroup().Schema, map[string]interface{}{
+		"name":  "accounting",
+		"users": []interface{}{1, 2},
+	})
+
+	f := newFakeMembers(nil, 2)
+	diags := reconcileNewMembership(d, f.membership())
+
+	if !reflect.DeepEqual(sortedInts(f.added), []int{1, 2}) || len(f.removed) != 0 {
+		t.Errorf("expected users 1 and 2 to be added, got additions %v and removals %v", f.added, f.removed)
+	}
+	if !diags.HasError() || !strings.HasPrefix(diags[0].Detail, "1 of 2 changes failed") {
+		t.Errorf("expected the failed addition to be reported, got %v", diags)
+	}
+}
+
+// fakeGroupClient serves a single group whose users are kept in members.
+type fakeGroupClient struct {
+	client.MayanEdmsClient
+	members *fakeMembers
+}
+
+func (c *fakeGroupClient) GetGroupById(ctx context.Context, id int) (*client.Group, error) {
+	return &client.Group{ID: id, Name: "accounting"}, nil
+}
+
+func (c *fakeGroupClient) UpdateGroup(ctx context.Context, group client.Group) (*client.Group, error) {
+	return &group, nil
+}
+
+func (c *fakeGroupClient) GetGroupUsers(ctx context.Context, groupId int) ([]int, error) {
+	return c.members.list(), nil
+}
+
+func (c *fakeGroupClient) AddGroupUser(ctx context.Context, groupId int, userId int) error {
+	return c.members.membership().add(userId)
+}
+
+func (c *fakeGroupClient) RemoveGroupUser(ctx context.Context, groupId int, userId int) error {
+	return c.members.membership().remove(userId)
+}
+
+func TestResourceGroupUpdateStateAfterError(t *testing.T) {
+	d := groupData(t, []int{1, 2}, []int{2, 3, 4})
+
+	c := &fakeGroupClient{members: newFakeMembers([]int{1, 2}, 1, 3)}
+	diags := resourceGroupUpdate(context.Background(), d, c)
+
+	if !diags.HasError() {
+		t.Fatal("expected the failed changes to be reported")
+	}
+
+	// The state holds what the server ended up with, so the failed changes are
+	// planned again on the next run.
+	var users []int
+	for _, user := range d.Get("users").(*schema.Set).List() {
+		users = append(users, user.(int))
+	}
+	if !reflect.DeepEqual(sortedInts(users), []int{1, 2, 4}) {
+		t.Errorf("expected users 1, 2 and 4 in state, got %v", users)
+	}
+	if d.Id() != "7" {
+		t.Errorf("expected the group to stay in state, got id %q", d.Id())
+	}
+}
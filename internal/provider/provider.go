@@ -2,10 +2,15 @@
 package provider
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/rfleming71/terraform-provider-mayan-edms/client"
 )
 
@@ -36,55 +41,197 @@ func New(version string) func() *schema.Provider {
 				},
 				"username": &schema.Schema{
 					Type:        schema.TypeString,
-					Required:    true,
+					Optional:    true,
 					DefaultFunc: schema.EnvDefaultFunc("MAYAN_EDMS_USER", nil),
-					Description: "User account for mayan edms api",
+					Description: "User account for mayan edms api. Required unless `token` is set",
 				},
 				"password": &schema.Schema{
 					Type:        schema.TypeString,
-					Required:    true,
+					Optional:    true,
+					Sensitive:   true,
 					DefaultFunc: schema.EnvDefaultFunc("MAYAN_EDMS_PASSWORD", nil),
-					Description: "Password for mayan edms api",
+					Description: "Password for mayan edms api. Required unless `token` is set",
+				},
+				"token": &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					DefaultFunc: schema.EnvDefaultFunc("MAYAN_EDMS_TOKEN", nil),
+					Description: "Pre-issued API token for mayan edms api. Cannot be combined with `username` and `password`",
 				},
 				"insecure": &schema.Schema{
 					Type:        schema.TypeBool,
 					Optional:    true,
-					Default:     false,
-					DefaultFunc: schema.EnvDefaultFunc("MAYAN_EDMS_INSECURE", nil),
+					DefaultFunc: schema.EnvDefaultFunc("MAYAN_EDMS_INSECURE", false),
 					Description: "Whether SSL should be verified or not",
 				},
+				"ca_cert_file": &schema.Schema{
+					Type:          schema.TypeString,
+					Optional:      true,
+					DefaultFunc:   schema.EnvDefaultFunc("MAYAN_EDMS_CA_CERT_FILE", nil),
+					ConflictsWith: []string{"ca_cert_pem"},
+					Description:   "Path to a PEM encoded certificate authority bundle used to verify the mayan edms host",
+				},
+				"ca_cert_pem": &schema.Schema{
+					Type:          schema.TypeString,
+					Optional:      true,
+					DefaultFunc:   schema.EnvDefaultFunc("MAYAN_EDMS_CA_CERT_PEM", nil),
+					ConflictsWith: []string{"ca_cert_file"},
+					Description:   "PEM encoded certificate authority bundle used to verify the mayan edms host",
+				},
+				"client_cert": &schema.Schema{
+					Type:         schema.TypeString,
+					Optional:     true,
+					DefaultFunc:  schema.EnvDefaultFunc("MAYAN_EDMS_CLIENT_CERT", nil),
+					RequiredWith: []string{"client_key"},
+					Description:  "PEM encoded client certificate, or the path to one, used for mutual TLS",
+				},
+				"client_key": &schema.Schema{
+					Type:         schema.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					DefaultFunc:  schema.EnvDefaultFunc("MAYAN_EDMS_CLIENT_KEY", nil),
+					RequiredWith: []string{"client_cert"},
+					Description:  "PEM encoded client private key, or the path to one, used for mutual TLS",
+				},
+				"min_tls_version": &schema.Schema{
+					Type:         schema.TypeString,
+					Optional:     true,
+					DefaultFunc:  schema.EnvDefaultFunc("MAYAN_EDMS_MIN_TLS_VERSION", "1.2"),
+					ValidateFunc: validation.StringInSlice(client.TLSVersions(), false),
+					Description:  "Minimum TLS version accepted when connecting to the mayan edms host",
+				},
+				"request_timeout": &schema.Schema{
+					Type:         schema.TypeInt,
+					Optional:     true,
+					DefaultFunc:  schema.EnvDefaultFunc("MAYAN_EDMS_REQUEST_TIMEOUT", 60),
+					ValidateFunc: validation.IntAtLeast(0),
+					Description:  "Number of seconds a single request to the mayan edms api may take. Set to `0` to disable the timeout",
+				},
+				"page_size": &schema.Schema{
+					Type:        schema.TypeInt,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("MAYAN_EDMS_PAGE_SIZE", 100),
+					Description: "Number of items requested per page when reading lists from the mayan edms api",
+				},
+				"max_retries": &schema.Schema{
+					Type:         schema.TypeInt,
+					Optional:     true,
+					DefaultFunc:  schema.EnvDefaultFunc("MAYAN_EDMS_MAX_RETRIES", 3),
+					ValidateFunc: validation.IntAtLeast(0),
+					Description:  "Number of times a request is retried after a connection error or a 429, 502, 503 or 504 response",
+				},
+				"retry_max_wait": &schema.Schema{
+					Type:         schema.TypeInt,
+					Optional:     true,
+					DefaultFunc:  schema.EnvDefaultFunc("MAYAN_EDMS_RETRY_MAX_WAIT", 30),
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "Maximum number of seconds to wait between two attempts of a request",
+				},
+				"retry_all_methods": &schema.Schema{
+					Type:        schema.TypeBool,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("MAYAN_EDMS_RETRY_ALL_METHODS", false),
+					Description: "Whether requests that are not idempotent, such as creating an object, are retried as well",
+				},
 			},
 			ResourcesMap: map[string]*schema.Resource{
-				"mayanedms_document_type":                resourceDocumentType(),
-				"mayanedms_webform_source":               resourceWebformSource(),
-				"mayanedms_watchfolder_source":           resourceWatchFolderSource(),
-				"mayanedms_stagingfolder_source":         resourceStagingFolderSource(),
-				"mayanedms_tag":                          resourceTag(),
-				"mayanedms_index_template":               resourceIndexTemplate(),
-				"mayanedms_index_template_node":          resourceIndexTemplateNode(),
-				"mayanedms_group":                        resourceGroup(),
-				"mayanedms_workflow_template":            resourceWorkflowTemplate(),
-				"mayanedms_workflow_template_state":      resourceWorkflowTemplateState(),
-				"mayanedms_workflow_template_transition": resourceWorkflowTemplateTransition(),
-				"mayanedms_role":                         resourceRole(),
-				"mayanedms_metadata_type":                resourceMetadataType(),
+				"mayanedms_document_type":                        resourceDocumentType(),
+				"mayanedms_document_type_metadata_type":          resourceDocumentTypeMetadataType(),
+				"mayanedms_document_type_ocr_settings":           resourceDocumentTypeOCRSettings(),
+				"mayanedms_document_type_quick_label":            resourceDocumentTypeQuickLabel(),
+				"mayanedms_webform_source":                       resourceWebformSource(),
+				"mayanedms_watchfolder_source":                   resourceWatchFolderSource(),
+				"mayanedms_stagingfolder_source":                 resourceStagingFolderSource(),
+				"mayanedms_imap_email_source":                    resourceImapEmailSource(),
+				"mayanedms_pop3_email_source":                    resourcePop3EmailSource(),
+				"mayanedms_tag":                                  resourceTag(),
+				"mayanedms_cabinet":                              resourceCabinet(),
+				"mayanedms_index_template":                       resourceIndexTemplate(),
+				"mayanedms_index_template_node":                  resourceIndexTemplateNode(),
+				"mayanedms_index_template_tree":                  resourceIndexTemplateTree(),
+				"mayanedms_index_rebuild":                        resourceIndexRebuild(),
+				"mayanedms_group":                                resourceGroup(),
+				"mayanedms_workflow":                             resourceWorkflow(),
+				"mayanedms_workflow_template":                    resourceWorkflowTemplate(),
+				"mayanedms_workflow_template_state":              resourceWorkflowTemplateState(),
+				"mayanedms_workflow_template_state_action":       resourceWorkflowTemplateStateAction(),
+				"mayanedms_workflow_template_state_escalation":   resourceWorkflowTemplateStateEscalation(),
+				"mayanedms_workflow_template_transition":         resourceWorkflowTemplateTransition(),
+				"mayanedms_workflow_template_transition_trigger": resourceWorkflowTemplateTransitionTrigger(),
+				"mayanedms_workflow_template_transition_field":   resourceWorkflowTemplateTransitionField(),
+				"mayanedms_role":                                 resourceRole(),
+				"mayanedms_metadata_type":                        resourceMetadataType(),
+				"mayanedms_user":                                 resourceUser(),
+				"mayanedms_access_control_list":                  resourceAccessControlList(),
 			},
-			ConfigureFunc: mayanEdmsConfigure,
+			DataSourcesMap: map[string]*schema.Resource{
+				"mayanedms_document_type":           dataSourceDocumentType(),
+				"mayanedms_tag":                     dataSourceTag(),
+				"mayanedms_cabinet":                 dataSourceCabinet(),
+				"mayanedms_group":                   dataSourceGroup(),
+				"mayanedms_role":                    dataSourceRole(),
+				"mayanedms_metadata_type":           dataSourceMetadataType(),
+				"mayanedms_index_template":          dataSourceIndexTemplate(),
+				"mayanedms_workflow_template":       dataSourceWorkflowTemplate(),
+				"mayanedms_workflow_template_graph": dataSourceWorkflowTemplateGraph(),
+				"mayanedms_source":                  dataSourceSource(),
+				"mayanedms_user":                    dataSourceUser(),
+			},
+			ConfigureContextFunc: mayanEdmsConfigure,
 		}
 		return p
 	}
 }
 
-func mayanEdmsConfigure(d *schema.ResourceData) (interface{}, error) {
+func mayanEdmsConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	url := d.Get("url").(string)
 	username := d.Get("username").(string)
 	password := d.Get("password").(string)
+	token := d.Get("token").(string)
+	if token != "" && (username != "" || password != "") {
+		return nil, diag.Diagnostics{attributeDiagnostic(diag.Error, "token", "Conflicting credentials", errors.New("token cannot be combined with username and password"))}
+	}
+	if token == "" && (username == "" || password == "") {
+		return nil, diag.Diagnostics{attributeDiagnostic(diag.Error, "username", "Missing credentials", errors.New("either token or both username and password must be set"))}
+	}
+
 	insecure := d.Get("insecure").(bool)
+	caCertFile := d.Get("ca_cert_file").(string)
+	caCertPem := d.Get("ca_cert_pem").(string)
+	clientCert := d.Get("client_cert").(string)
+	clientKey := d.Get("client_key").(string)
+	minTlsVersion := d.Get("min_tls_version").(string)
+	requestTimeout := d.Get("request_timeout").(int)
+	pageSize := d.Get("page_size").(int)
+	maxRetries := d.Get("max_retries").(int)
+	retryMaxWait := d.Get("retry_max_wait").(int)
+	retryAllMethods := d.Get("retry_all_methods").(bool)
 	config := client.ClientConfig{
 		Url:                url,
 		Username:           username,
 		Password:           password,
+		Token:              token,
 		InsecureSkipVerify: insecure,
+		CACertFile:         caCertFile,
+		CACertPEM:          caCertPem,
+		ClientCert:         clientCert,
+		ClientKey:          clientKey,
+		MinTLSVersion:      minTlsVersion,
+		RequestTimeout:     time.Duration(requestTimeout) * time.Second,
+		PageSize:           pageSize,
+		MaxRetries:         maxRetries,
+		RetryMaxWait:       time.Duration(retryMaxWait) * time.Second,
+		RetryAllMethods:    retryAllMethods,
+	}
+	c, err := client.NewMayanEdmsClient(ctx, config)
+	if err != nil {
+		return nil, diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Unable to create mayan edms client",
+			Detail:   err.Error(),
+		}}
 	}
-	return client.NewMayanEdmsClient(config)
+
+	return c, nil
 }
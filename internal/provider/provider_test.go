@@ -0,0 +1,35 @@
+package provider
+
+import "testing"
+
+func TestProvider(t *testing.T) {
+	if err := New("dev")().InternalValidate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestProviderInsecureDefault(t *testing.T) {
+	cases := []struct {
+		name     string
+		env      string
+		expected interface{}
+	}{
+		{name: "unset", env: "", expected: false},
+		{name: "enabled", env: "true", expected: "true"},
+		{name: "disabled", env: "false", expected: "false"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("MAYAN_EDMS_INSECURE", tc.env)
+
+			value, err := New("dev")().Schema["insecure"].DefaultValue()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if value != tc.expected {
+				t.Errorf("expected %#v, got %#v", tc.expected, value)
+			}
+		})
+	}
+}
@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rfleming71/terraform-provider-mayan-edms/client"
+)
+
+func resourceAccessControlList() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAccessControlListCreate,
+		ReadContext:   resourceAccessControlListRead,
+		UpdateContext: resourceAccessControlListUpdate,
+		DeleteContext: resourceAccessControlListDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAccessControlListImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"content_type": {
+				Description:  "Content type of the object the access is granted on, in the `app_label.model` form. For example `documents.documenttype`, `tags.tag`, `cabinets.cabinet`, `document_indexing.indextemplate` or `document_states.workflow`.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\w+\.\w+$`), "must be in the app_label.model form"),
+			},
+			"object_id": {
+				Description: "Id of the object the access is granted on.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"role": {
+				Description: "Id of the role being granted access.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"permissions": {
+				Description: "Permissions the role is granted on the object.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceAccessControlListCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	newAcl := dataToAccessControlList(d)
+
+	acl, err := c.CreateAccessControlList(ctx, *newAcl)
+
+	if err != nil {
+		return apiErrorDiagnostics(d, "Unable to create access control list", err)
+	}
+
+	d.SetId(fmt.Sprintf("%v-%v-%v", acl.ContentType, acl.ObjectID, acl.RoleID))
+
+	diags := reconcileNewMembership(d, accessControlListPermissionMembership(ctx, c, acl))
+
+	return append(diags, resourceAccessControlListRead(ctx, d, m)...)
+}
+
+func resourceAccessControlListRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	acl, err := findAccessControlList(ctx, c, d.Id())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Access control list not found, removing from state", map[string]interface{}{
+				"id": d.Id(),
+			})
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(accessControlListToData(ctx, c, acl, d))
+}
+
+func resourceAccessControlListUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	acl, err := findAccessControlList(ctx, c, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	diags := reconcileMembershipChange(d, accessControlListPermissionMembership(ctx, c, acl))
+
+	return append(diags, resourceAccessControlListRead(ctx, d, m)...)
+}
+
+func resourceAccessControlListDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	acl, err := findAccessControlList(ctx, c, d.Id())
+	if err != nil {
+		if client.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	err = c.DeleteAccessControlList(ctx, acl.ContentType, acl.ObjectID, acl.ID)
+	if err == nil {
+		d.SetId("")
+	}
+
+	return diag.FromErr(err)
+}
+
+func resourceAccessControlListImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(client.MayanEdmsClient)
+	rd := []*schema.ResourceData{d}
+	acl, err := findAccessControlList(ctx, c, d.Id())
+	if err != nil {
+		return rd, err
+	}
+
+	err = accessControlListToData(ctx, c, acl, d)
+	return rd, err
+}
+
+// findAccessControlList looks up the access control list of an id of the form
+// "<content_type>-<object_id>-<role_id>".
+func findAccessControlList(ctx context.Context, c client.MayanEdmsClient, id string) (*client.AccessControlList, error) {
+	contentType, objectId, roleId, err := breakAccessControlListId(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetAccessControlListByRole(ctx, contentType, objectId, roleId)
+}
+
+func accessControlListPermissionMembership(ctx context.Context, c client.MayanEdmsClient, acl *client.AccessControlList) membership {
+	return membership{
+		attribute: "permissions",
+		member:    "permission",
+		owner:     "access control list",
+		add: func(permission interface{}) error {
+			return c.AddAccessControlListPermission(ctx, acl.ContentType, acl.ObjectID, acl.ID, permission.(string))
+		},
+		remove: func(permission interface{}) error {
+			return c.RemoveAccessControlListPermission(ctx, acl.ContentType, acl.ObjectID, acl.ID, permission.(string))
+		},
+	}
+}
+
+// breakAccessControlListId splits an id of the form
+// "<content_type>-<object_id>-<role_id>".
+func breakAccessControlListId(id string) (string, int, int, error) {
+	parts := strings.Split(id, "-")
+	if len(parts) != 3 {
+		return "", 0, 0, fmt.Errorf("unexpected access control list id %q, expected <content_type>-<object_id>-<role_id>", id)
+	}
+
+	objectId, roleId, err := breakCompositeId(parts[1] + "-" + parts[2])
+	if err != nil {
+		return "", 0, 0, err
+	}
+
+	return parts[0], objectId, roleId, nil
+}
+
+func accessControlListToData(ctx context.Context, c client.MayanEdmsClient, acl *client.AccessControlList, d *schema.ResourceData) error {
+	permissions, err := c.GetAccessControlListPermissions(ctx, acl.ContentType, acl.ObjectID, acl.ID)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%v-%v-%v", acl.ContentType, acl.ObjectID, acl.RoleID))
+	if err := d.Set("permissions", permissions); err != nil {
+		return err
+	}
+	if err := d.Set("content_type", acl.ContentType); err != nil {
+		return err
+	}
+	if err := d.Set("object_id", acl.ObjectID); err != nil {
+		return err
+	}
+	if err := d.Set("role", acl.RoleID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func dataToAccessControlList(d *schema.ResourceData) *client.AccessControlList {
+	newAcl := client.AccessControlList{
+		ContentType: d.Get("content_type").(string),
+		ObjectID:    d.Get("object_id").(int),
+		RoleID:      d.Get("role").(int),
+	}
+
+	return &newAcl
+}
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rfleming71/terraform-provider-mayan-edms/client"
+)
+
+func resourceCabinet() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCabinetCreate,
+		ReadContext:   resourceCabinetRead,
+		UpdateContext: resourceCabinetUpdate,
+		DeleteContext: resourceCabinetDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCabinetImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"label": {
+				Description:  "Short text used as the cabinet name. Labels must be unique among the children of a cabinet.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringDoesNotContainAny("/"),
+			},
+			"parent_id": {
+				Description: "Id of the cabinet this cabinet is nested in. Leave unset for a top level cabinet.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+			},
+			"full_path": {
+				Description: "Labels of the cabinet and its parents, from the top level cabinet down, separated by `/`, such as `Finance/Invoices/2026`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceCabinetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	newCabinet := dataToCabinet(d)
+
+	cabinet, err := c.CreateCabinet(ctx, *newCabinet)
+
+	if err != nil {
+		return apiErrorDiagnostics(d, "Unable to create cabinet", err)
+	}
+
+	d.SetId(fmt.Sprintf("%v", cabinet.ID))
+
+	return resourceCabinetRead(ctx, d, m)
+}
+
+func resourceCabinetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	id, _ := strconv.Atoi(d.Id())
+
+	source, err := c.GetCabinetById(ctx, id)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Cabinet not found, removing from state", map[string]interface{}{
+				"id": d.Id(),
+			})
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(cabinetToData(source, d))
+}
+
+func resourceCabinetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	cabinet := dataToCabinet(d)
+	cabinet.ID, _ = strconv.Atoi(d.Id())
+	_, err := c.UpdateCabinet(ctx, *cabinet)
+	if err != nil {
+		return apiErrorDiagnostics(d, "Unable to update cabinet", err)
+	}
+
+	return resourceCabinetRead(ctx, d, m)
+}
+
+func resourceCabinetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	id, _ := strconv.Atoi(d.Id())
+
+	// Deleting a cabinet deletes the cabinets nested in it, which may already
+	// be gone when the whole tree is destroyed.
+	err := c.DeleteCabinet(ctx, id)
+	if err == nil || client.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
+
+	return diag.FromErr(err)
+}
+
+func resourceCabinetImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(client.MayanEdmsClient)
+	id, err := strconv.Atoi(d.Id())
+	rd := []*schema.ResourceData{d}
+	if err != nil {
+		return rd, err
+	}
+
+	cabinet, err := c.GetCabinetById(ctx, id)
+	if err != nil {
+		return rd, err
+	}
+
+	err = cabinetToData(cabinet, d)
+	return rd, err
+}
+
+// cabinetPath normalizes a cabinet path, Mayan EDMS separates the labels of
+// the full path with " / ".
+func cabinetPath(path string) string {
+	labels := strings.Split(path, "/")
+	for i, label := range labels {
+		labels[i] = strings.TrimSpace(label)
+	}
+
+	return strings.Join(labels, "/")
+}
+
+func cabinetToData(cabinet *client.Cabinet, d *schema.ResourceData) error {
+	d.SetId(fmt.Sprintf("%v", cabinet.ID))
+	if err := d.Set("label", cabinet.Label); err != nil {
+		return err
+	}
+
+	parentId := 0
+	if cabinet.Parent != nil {
+		parentId = *cabinet.Parent
+	}
+	if err := d.Set("parent_id", parentId); err != nil {
+		return err
+	}
+
+	if err := d.Set("full_path", cabinetPath(cabinet.FullPath)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func dataToCabinet(d *schema.ResourceData) *client.Cabinet {
+	id, _ := strconv.Atoi(d.Id())
+	newCabinet := client.Cabinet{
+		ID:    id,
+		Label: d.Get("label").(string),
+	}
+
+	if parentId := d.Get("parent_id").(int); parentId != 0 {
+		newCabinet.Parent = &parentId
+	}
+
+	return &newCabinet
+}
@@ -1,9 +1,13 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/rfleming71/terraform-provider-mayan-edms/client"
@@ -11,12 +15,17 @@ import (
 
 func resourceDocumentType() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceDocumentTypeCreate,
-		Read:   resourceDocumentTypeRead,
-		Update: resourceDocumentTypeUpdate,
-		Delete: resourceDocumentTypeDelete,
+		CreateContext: resourceDocumentTypeCreate,
+		ReadContext:   resourceDocumentTypeRead,
+		UpdateContext: resourceDocumentTypeUpdate,
+		DeleteContext: resourceDocumentTypeDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceDocumentTypeImport,
+			StateContext: resourceDocumentTypeImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -67,57 +76,64 @@ func resourceDocumentType() *schema.Resource {
 	}
 }
 
-func resourceDocumentTypeCreate(d *schema.ResourceData, m interface{}) error {
+func resourceDocumentTypeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	newDocType := dataToDocumentType(d)
 
-	docType, err := c.CreateDocumentType(*newDocType)
+	docType, err := c.CreateDocumentType(ctx, *newDocType)
 
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(d, "Unable to create document type", err)
 	}
 
 	d.SetId(fmt.Sprintf("%v", docType.ID))
 
-	return resourceDocumentTypeRead(d, m)
+	return resourceDocumentTypeRead(ctx, d, m)
 }
 
-func resourceDocumentTypeRead(d *schema.ResourceData, m interface{}) error {
+func resourceDocumentTypeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	id, _ := strconv.Atoi(d.Id())
 
-	docType, err := c.GetDocumentTypeById(id)
+	docType, err := c.GetDocumentTypeById(ctx, id)
 	if err != nil {
-		return err
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Document type not found, removing from state", map[string]interface{}{
+				"id": d.Id(),
+			})
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
 	}
 
-	return documentTypeToData(docType, d)
+	return diag.FromErr(documentTypeToData(docType, d))
 }
 
-func resourceDocumentTypeUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceDocumentTypeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	docType := dataToDocumentType(d)
 	docType.ID, _ = strconv.Atoi(d.Id())
-	_, err := c.UpdateDocumentType(*docType)
+	_, err := c.UpdateDocumentType(ctx, *docType)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(d, "Unable to update document type", err)
 	}
 
-	return resourceDocumentTypeRead(d, m)
+	return resourceDocumentTypeRead(ctx, d, m)
 }
 
-func resourceDocumentTypeDelete(d *schema.ResourceData, m interface{}) error {
+func resourceDocumentTypeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	id, _ := strconv.Atoi(d.Id())
-	err := c.DeleteDocumentType(id)
+	err := c.DeleteDocumentType(ctx, id)
 	if err == nil {
 		d.SetId("")
 	}
 
-	return err
+	return diag.FromErr(err)
 }
 
-func resourceDocumentTypeImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceDocumentTypeImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	c := m.(client.MayanEdmsClient)
 	id, err := strconv.Atoi(d.Id())
 	rd := []*schema.ResourceData{d}
@@ -125,7 +141,7 @@ func resourceDocumentTypeImport(d *schema.ResourceData, m interface{}) ([]*schem
 		return rd, err
 	}
 
-	docType, err := c.GetDocumentTypeById(id)
+	docType, err := c.GetDocumentTypeById(ctx, id)
 	if err != nil {
 		return rd, err
 	}
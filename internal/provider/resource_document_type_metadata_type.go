@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rfleming71/terraform-provider-mayan-edms/client"
+)
+
+func resourceDocumentTypeMetadataType() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDocumentTypeMetadataTypeCreate,
+		ReadContext:   resourceDocumentTypeMetadataTypeRead,
+		UpdateContext: resourceDocumentTypeMetadataTypeUpdate,
+		DeleteContext: resourceDocumentTypeMetadataTypeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDocumentTypeMetadataTypeImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"document_type_id": {
+				Description: "Id of the document type the metadata type is attached to.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"metadata_type_id": {
+				Description: "Id of the metadata type to attach.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"required": {
+				Description: "Documents of this type can't be uploaded without a value for the metadata type.",
+				Type:        schema.TypeBool,
+				Default:     false,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func resourceDocumentTypeMetadataTypeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	documentTypeId, newMetadataType := dataToDocumentTypeMetadataType(d)
+
+	metadataType, err := c.CreateDocumentTypeMetadataType(ctx, documentTypeId, *newMetadataType)
+
+	if err != nil {
+		return apiErrorDiagnostics(d, "Unable to create document type metadata type", err)
+	}
+
+	d.SetId(fmt.Sprintf("%v-%v", documentTypeId, metadataType.ID))
+
+	return resourceDocumentTypeMetadataTypeRead(ctx, d, m)
+}
+
+func resourceDocumentTypeMetadataTypeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	documentTypeId, id, err := getIdInformation(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	metadataType, err := c.GetDocumentTypeMetadataType(ctx, documentTypeId, id)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Document type metadata type not found, removing from state", map[string]interface{}{
+				"id": d.Id(),
+			})
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(documentTypeMetadataTypeToData(documentTypeId, metadataType, d))
+}
+
+func resourceDocumentTypeMetadataTypeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	documentTypeId, metadataType := dataToDocumentTypeMetadataType(d)
+
+	_, err := c.UpdateDocumentTypeMetadataType(ctx, documentTypeId, *metadataType)
+	if err != nil {
+		return apiErrorDiagnostics(d, "Unable to update document type metadata type", err)
+	}
+
+	return resourceDocumentTypeMetadataTypeRead(ctx, d, m)
+}
+
+func resourceDocumentTypeMetadataTypeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	documentTypeId, id, err := getIdInformation(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = c.RemoveDocumentTypeMetadataType(ctx, documentTypeId, id)
+	if err == nil {
+		d.SetId("")
+	}
+
+	return diag.FromErr(err)
+}
+
+func resourceDocumentTypeMetadataTypeImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(client.MayanEdmsClient)
+	documentTypeId, id, err := getIdInformation(d)
+	rd := []*schema.ResourceData{d}
+	if err != nil {
+		return rd, err
+	}
+
+	metadataType, err := c.GetDocumentTypeMetadataType(ctx, documentTypeId, id)
+	if err != nil {
+		return rd, err
+	}
+
+	err = documentTypeMetadataTypeToData(documentTypeId, metadataType, d)
+	return rd, err
+}
+
+func documentTypeMetadataTypeToData(documentTypeId int, metadataType *client.DocumentTypeMetadataType, d *schema.ResourceData) error {
+	d.SetId(fmt.Sprintf("%v-%v", documentTypeId, metadataType.ID))
+	if err := d.Set("document_type_id", documentTypeId); err != nil {
+		return err
+	}
+	if err := d.Set("metadata_type_id", metadataType.MetadataTypeID); err != nil {
+		return err
+	}
+	if err := d.Set("required", metadataType.Required); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func dataToDocumentTypeMetadataType(d *schema.ResourceData) (int, *client.DocumentTypeMetadataType) {
+	_, id, _ := getIdInformation(d)
+	metadataType := client.DocumentTypeMetadataType{
+		ID:             id,
+		MetadataTypeID: d.Get("metadata_type_id").(int),
+		Required:       d.Get("required").(bool),
+	}
+
+	return d.Get("document_type_id").(int), &metadataType
+}
@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rfleming71/terraform-provider-mayan-edms/client"
+)
+
+func resourceDocumentTypeOCRSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDocumentTypeOCRSettingsCreate,
+		ReadContext:   resourceDocumentTypeOCRSettingsRead,
+		UpdateContext: resourceDocumentTypeOCRSettingsUpdate,
+		DeleteContext: resourceDocumentTypeOCRSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDocumentTypeOCRSettingsImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"document_type_id": {
+				Description: "Id of the document type the settings apply to. Every document type has settings, destroying this resource leaves them as they are.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"auto_ocr": {
+				Description: "Automatically queue newly created documents for OCR.",
+				Type:        schema.TypeBool,
+				Default:     true,
+				Optional:    true,
+			},
+			"auto_parsing": {
+				Description: "Automatically queue newly created documents for text content parsing.",
+				Type:        schema.TypeBool,
+				Default:     true,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func resourceDocumentTypeOCRSettingsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	documentTypeId := d.Get("document_type_id").(int)
+
+	if diags := updateDocumentTypeOCRSettings(ctx, c, documentTypeId, d); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(fmt.Sprintf("%v", documentTypeId))
+
+	return resourceDocumentTypeOCRSettingsRead(ctx, d, m)
+}
+
+func resourceDocumentTypeOCRSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	id, _ := strconv.Atoi(d.Id())
+
+	err := documentTypeOCRSettingsToData(ctx, c, id, d)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Document type not found, removing OCR settings from state", map[string]interface{}{
+				"id": d.Id(),
+			})
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceDocumentTypeOCRSettingsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	id, _ := strconv.Atoi(d.Id())
+
+	if diags := updateDocumentTypeOCRSettings(ctx, c, id, d); diags.HasError() {
+		return diags
+	}
+
+	return resourceDocumentTypeOCRSettingsRead(ctx, d, m)
+}
+
+func resourceDocumentTypeOCRSettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// The settings live as long as the document type, forgetting them is all
+	// there is to do.
+	d.SetId("")
+	return nil
+}
+
+func resourceDocumentTypeOCRSettingsImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(client.MayanEdmsClient)
+	id, err := strconv.Atoi(d.Id())
+	rd := []*schema.ResourceData{d}
+	if err != nil {
+		return rd, err
+	}
+
+	err = documentTypeOCRSettingsToData(ctx, c, id, d)
+	return rd, err
+}
+
+func updateDocumentTypeOCRSettings(ctx context.Context, c client.MayanEdmsClient, documentTypeId int, d *schema.ResourceData) diag.Diagnostics {
+	_, err := c.UpdateDocumentTypeOCRSettings(ctx, documentTypeId, client.DocumentTypeOCRSettings{
+		AutoOCR: d.Get("auto_ocr").(bool),
+	})
+	if err != nil {
+		return apiErrorDiagnostics(d, "Unable to update document type OCR settings", err)
+	}
+
+	_, err = c.UpdateDocumentTypeParsingSettings(ctx, documentTypeId, client.DocumentTypeParsingSettings{
+		AutoParsing: d.Get("auto_parsing").(bool),
+	})
+	if err != nil {
+		return apiErrorDiagnostics(d, "Unable to update document type parsing settings", err)
+	}
+
+	return nil
+}
+
+func documentTypeOCRSettingsToData(ctx context.Context, c client.MayanEdmsClient, documentTypeId int, d *schema.ResourceData) error {
+	ocrSettings, err := c.GetDocumentTypeOCRSettings(ctx, documentTypeId)
+	if err != nil {
+		return err
+	}
+
+	parsingSettings, err := c.GetDocumentTypeParsingSettings(ctx, documentTypeId)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%v", documentTypeId))
+	if err := d.Set("document_type_id", documentTypeId); err != nil {
+		return err
+	}
+	if err := d.Set("auto_ocr", ocrSettings.AutoOCR); err != nil {
+		return err
+	}
+	if err := d.Set("auto_parsing", parsingSettings.AutoParsing); err != nil {
+		return err
+	}
+
+	return nil
+}
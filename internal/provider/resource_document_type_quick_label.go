@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rfleming71/terraform-provider-mayan-edms/client"
+)
+
+func resourceDocumentTypeQuickLabel() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDocumentTypeQuickLabelCreate,
+		ReadContext:   resourceDocumentTypeQuickLabelRead,
+		UpdateContext: resourceDocumentTypeQuickLabelUpdate,
+		DeleteContext: resourceDocumentTypeQuickLabelDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDocumentTypeQuickLabelImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"document_type_id": {
+				Description: "Id of the document type the quick label is offered for.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"label": {
+				Description: "Label offered to uploaders of documents of the document type.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"enabled": {
+				Description: "Offer the label to uploaders. Disabled labels are kept but not offered.",
+				Type:        schema.TypeBool,
+				Default:     true,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func resourceDocumentTypeQuickLabelCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	documentTypeId, newQuickLabel := dataToDocumentTypeQuickLabel(d)
+
+	quickLabel, err := c.CreateDocumentTypeQuickLabel(ctx, documentTypeId, *newQuickLabel)
+
+	if err != nil {
+		return apiErrorDiagnostics(d, "Unable to create document type quick label", err)
+	}
+
+	d.SetId(fmt.Sprintf("%v-%v", documentTypeId, quickLabel.ID))
+
+	return resourceDocumentTypeQuickLabelRead(ctx, d, m)
+}
+
+func resourceDocumentTypeQuickLabelRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	documentTypeId, id, err := getIdInformation(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	quickLabel, err := c.GetDocumentTypeQuickLabel(ctx, documentTypeId, id)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Document type quick label not found, removing from state", map[string]interface{}{
+				"id": d.Id(),
+			})
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(documentTypeQuickLabelToData(documentTypeId, quickLabel, d))
+}
+
+func resourceDocumentTypeQuickLabelUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	documentTypeId, quickLabel := dataToDocumentTypeQuickLabel(d)
+
+	_, err := c.UpdateDocumentTypeQuickLabel(ctx, documentTypeId, *quickLabel)
+	if err != nil {
+		return apiErrorDiagnostics(d, "Unable to update document type quick label", err)
+	}
+
+	return resourceDocumentTypeQuickLabelRead(ctx, d, m)
+}
+
+func resourceDocumentTypeQuickLabelDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	documentTypeId, id, err := getIdInformation(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = c.RemoveDocumentTypeQuickLabel(ctx, documentTypeId, id)
+	if err == nil {
+		d.SetId("")
+	}
+
+	return diag.FromErr(err)
+}
+
+func resourceDocumentTypeQuickLabelImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(client.MayanEdmsClient)
+	documentTypeId, id, err := getIdInformation(d)
+	rd := []*schema.ResourceData{d}
+	if err != nil {
+		return rd, err
+	}
+
+	quickLabel, err := c.GetDocumentTypeQuickLabel(ctx, documentTypeId, id)
+	if err != nil {
+		return rd, err
+	}
+
+	err = documentTypeQuickLabelToData(documentTypeId, quickLabel, d)
+	return rd, err
+}
+
+func documentTypeQuickLabelToData(documentTypeId int, quickLabel *client.DocumentTypeQuickLabel, d *schema.ResourceData) error {
+	d.SetId(fmt.Sprintf("%v-%v", documentTypeId, quickLabel.ID))
+	if err := d.Set("document_type_id", documentTypeId); err != nil {
+		return err
+	}
+	if err := d.Set("label", quickLabel.Label); err != nil {
+		return err
+	}
+	if err := d.Set("enabled", quickLabel.Enabled); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func dataToDocumentTypeQuickLabel(d *schema.ResourceData) (int, *client.DocumentTypeQuickLabel) {
+	_, id, _ := getIdInformation(d)
+	quickLabel := client.DocumentTypeQuickLabel{
+		ID:      id,
+		Label:   d.Get("label").(string),
+		Enabled: d.Get("enabled").(bool),
+	}
+
+	return d.Get("document_type_id").(int), &quickLabel
+}
@@ -1,21 +1,30 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/rfleming71/terraform-provider-mayan-edms/client"
 )
 
 func resourceGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceGroupCreate,
-		Read:   resourceGroupRead,
-		Update: resourceGroupUpdate,
-		Delete: resourceGroupDelete,
+		CreateContext: resourceGroupCreate,
+		ReadContext:   resourceGroupRead,
+		UpdateContext: resourceGroupUpdate,
+		DeleteContext: resourceGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceGroupImport,
+			StateContext: resourceGroupImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -35,98 +44,77 @@ func resourceGroup() *schema.Resource {
 	}
 }
 
-func resourceGroupCreate(d *schema.ResourceData, m interface{}) error {
+func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	newGroup := dataToGroup(d)
 
-	group, err := c.CreateGroup(*newGroup)
+	group, err := c.CreateGroup(ctx, *newGroup)
 
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(d, "Unable to create group", err)
 	}
 
 	d.SetId(fmt.Sprintf("%v", group.ID))
 
-	ids := d.Get("users").(*schema.Set).List()
-	userIds := []int{}
-	for _, id := range ids {
-		t := id.(int)
-		userIds = append(userIds, t)
-	}
-
-	for _, id := range userIds {
-		_ = c.AddGroupUser(group.ID, id)
-	}
+	diags := reconcileNewMembership(d, groupUserMembership(ctx, c, group.ID))
 
-	return resourceGroupRead(d, m)
+	return append(diags, resourceGroupRead(ctx, d, m)...)
 }
 
-func resourceGroupRead(d *schema.ResourceData, m interface{}) error {
+func resourceGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	id, _ := strconv.Atoi(d.Id())
 
-	group, err := c.GetGroupById(id)
+	group, err := c.GetGroupById(ctx, id)
 	if err != nil {
-		return err
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Group not found, removing from state", map[string]interface{}{
+				"id": d.Id(),
+			})
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
 	}
 
-	userIds, err := c.GetGroupUsers(group.ID)
+	userIds, err := c.GetGroupUsers(ctx, group.ID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("users", userIds); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return groupToData(group, d)
+	return diag.FromErr(groupToData(group, d))
 }
 
-func resourceGroupUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	group := dataToGroup(d)
 	group.ID, _ = strconv.Atoi(d.Id())
-	_, err := c.UpdateGroup(*group)
+	_, err := c.UpdateGroup(ctx, *group)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(d, "Unable to update group", err)
 	}
 
-	if d.HasChange("users") {
-		o, n := d.GetChange("users")
-
-		oTypes := o.(*schema.Set)
-		nTypes := n.(*schema.Set)
+	diags := reconcileMembershipChange(d, groupUserMembership(ctx, c, group.ID))
 
-		removals := oTypes.Difference(nTypes)
-		for _, removal := range removals.List() {
-			if err := c.RemoveGroupUser(group.ID, removal.(int)); err != nil {
-				return err
-			}
-		}
-
-		additions := nTypes.Difference(oTypes)
-		for _, addition := range additions.List() {
-			if err := c.AddGroupUser(group.ID, addition.(int)); err != nil {
-				return err
-			}
-		}
-	}
-
-	return resourceGroupRead(d, m)
+	return append(diags, resourceGroupRead(ctx, d, m)...)
 }
 
-func resourceGroupDelete(d *schema.ResourceData, m interface{}) error {
+func resourceGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	id, _ := strconv.Atoi(d.Id())
-	err := c.DeleteGroup(id)
+	err := c.DeleteGroup(ctx, id)
 	if err == nil {
 		d.SetId("")
 	}
 
-	return err
+	return diag.FromErr(err)
 }
 
-func resourceGroupImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceGroupImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	c := m.(client.MayanEdmsClient)
 	id, err := strconv.Atoi(d.Id())
 	rd := []*schema.ResourceData{d}
@@ -134,12 +122,12 @@ func resourceGroupImport(d *schema.ResourceData, m interface{}) ([]*schema.Resou
 		return rd, err
 	}
 
-	group, err := c.GetGroupById(id)
+	group, err := c.GetGroupById(ctx, id)
 	if err != nil {
 		return rd, err
 	}
 
-	userIds, err := c.GetGroupUsers(group.ID)
+	userIds, err := c.GetGroupUsers(ctx, group.ID)
 	if err != nil {
 		return rd, err
 	}
@@ -152,6 +140,20 @@ func resourceGroupImport(d *schema.ResourceData, m interface{}) ([]*schema.Resou
 	return rd, err
 }
 
+func groupUserMembership(ctx context.Context, c client.MayanEdmsClient, groupId int) membership {
+	return membership{
+		attribute: "users",
+		member:    "user",
+		owner:     "group",
+		add: func(userId interface{}) error {
+			return c.AddGroupUser(ctx, groupId, userId.(int))
+		},
+		remove: func(userId interface{}) error {
+			return c.RemoveGroupUser(ctx, groupId, userId.(int))
+		},
+	}
+}
+
 func groupToData(group *client.Group, d *schema.ResourceData) error {
 	d.SetId(fmt.Sprintf("%v", group.ID))
 	if err := d.Set("name", group.Name); err != nil {
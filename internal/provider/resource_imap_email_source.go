@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rfleming71/terraform-provider-mayan-edms/client"
+)
+
+const imapEmailSourceBackendPath = "mayan.apps.sources.source_backends.email_backends.SourceBackendIMAPEmail"
+
+type imapEmailSourceBackendDataType struct {
+	emailSourceBackendDataType
+	Mailbox            string `json:"mailbox"`
+	SearchCriteria     string `json:"search_criteria"`
+	StoreCommands      string `json:"store_commands"`
+	MailboxDestination string `json:"mailbox_destination"`
+	ExecuteExpunge     bool   `json:"execute_expunge"`
+}
+
+func resourceImapEmailSource() *schema.Resource {
+	s := emailSourceSchema(993)
+	s["mailbox"] = &schema.Schema{
+		Description: "IMAP mailbox from which to check for messages.",
+		Type:        schema.TypeString,
+		Default:     "INBOX",
+		Optional:    true,
+	}
+	s["search_criteria"] = &schema.Schema{
+		Description: "Criteria to use when searching for messages to process, in the IMAP SEARCH command syntax.",
+		Type:        schema.TypeString,
+		Default:     "NOT DELETED",
+		Optional:    true,
+	}
+	s["store_commands"] = &schema.Schema{
+		Description: "IMAP STORE commands to execute on messages after they are processed, one per line.",
+		Type:        schema.TypeString,
+		Default:     "+FLAGS (\\Deleted)",
+		Optional:    true,
+	}
+	s["mailbox_destination"] = &schema.Schema{
+		Description: "IMAP mailbox to which processed messages are copied.",
+		Type:        schema.TypeString,
+		Default:     "",
+		Optional:    true,
+	}
+	s["execute_expunge"] = &schema.Schema{
+		Description: "Execute the IMAP expunge command after processing each message, permanently removing the messages flagged as deleted.",
+		Type:        schema.TypeBool,
+		Default:     true,
+		Optional:    true,
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceImapEmailSourceCreate,
+		ReadContext:   resourceImapEmailSourceRead,
+		UpdateContext: resourceImapEmailSourceUpdate,
+		DeleteContext: resourceImapEmailSourceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceImapEmailSourceImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: s,
+	}
+}
+
+func resourceImapEmailSourceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	newSource := dataToImapEmailSource(d)
+
+	source, err := c.CreateSource(ctx, *newSource)
+
+	if err != nil {
+		return apiErrorDiagnostics(d, "Unable to create IMAP email source", err)
+	}
+
+	d.SetId(fmt.Sprintf("%v", source.ID))
+
+	return resourceImapEmailSourceRead(ctx, d, m)
+}
+
+func resourceImapEmailSourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	id, _ := strconv.Atoi(d.Id())
+
+	source, err := c.GetSourceById(ctx, id)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "IMAP email source not found, removing from state", map[string]interface{}{
+				"id": d.Id(),
+			})
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(imapEmailSourceToData(source, d))
+}
+
+func resourceImapEmailSourceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	source := dataToImapEmailSource(d)
+	source.ID, _ = strconv.Atoi(d.Id())
+	_, err := c.UpdateSource(ctx, *source)
+	if err != nil {
+		return apiErrorDiagnostics(d, "Unable to update IMAP email source", err)
+	}
+
+	return resourceImapEmailSourceRead(ctx, d, m)
+}
+
+func resourceImapEmailSourceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	id, _ := strconv.Atoi(d.Id())
+	err := c.DeleteSource(ctx, id)
+	if err == nil {
+		d.SetId("")
+	}
+
+	return diag.FromErr(err)
+}
+
+func resourceImapEmailSourceImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(client.MayanEdmsClient)
+	id, err := strconv.Atoi(d.Id())
+	rd := []*schema.ResourceData{d}
+	if err != nil {
+		return rd, err
+	}
+
+	source, err := c.GetSourceById(ctx, id)
+	if err != nil {
+		return rd, err
+	}
+
+	if source.BackendPath != imapEmailSourceBackendPath {
+		return rd, errors.New("identified source is not of type IMAP email")
+	}
+
+	err = imapEmailSourceToData(source, d)
+	return rd, err
+}
+
+func imapEmailSourceToData(source *client.Source, d *schema.ResourceData) error {
+	d.SetId(fmt.Sprintf("%v", source.ID))
+	if err := d.Set("label", source.Label); err != nil {
+		return err
+	}
+	if err := d.Set("enabled", source.Enabled); err != nil {
+		return err
+	}
+
+	var backendData imapEmailSourceBackendDataType
+	_ = json.Unmarshal([]byte(source.BackendData), &backendData)
+
+	if err := emailSourceToData(backendData.emailSourceBackendDataType, d); err != nil {
+		return err
+	}
+
+	if err := d.Set("mailbox", backendData.Mailbox); err != nil {
+		return err
+	}
+
+	if err := d.Set("search_criteria", backendData.SearchCriteria); err != nil {
+		return err
+	}
+
+	if err := d.Set("store_commands", backendData.StoreCommands); err != nil {
+		return err
+	}
+
+	if err := d.Set("mailbox_destination", backendData.MailboxDestination); err != nil {
+		return err
+	}
+
+	if err := d.Set("execute_expunge", backendData.ExecuteExpunge); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func dataToImapEmailSource(d *schema.ResourceData) *client.Source {
+	backendData, _ := json.Marshal(imapEmailSourceBackendDataType{
+		emailSourceBackendDataType: dataToEmailSourceBackendData(d),
+		Mailbox:                    d.Get("mailbox").(string),
+		SearchCriteria:             d.Get("search_criteria").(string),
+		StoreCommands:              d.Get("store_commands").(string),
+		MailboxDestination:         d.Get("mailbox_destination").(string),
+		ExecuteExpunge:             d.Get("execute_expunge").(bool),
+	})
+	id, _ := strconv.Atoi(d.Id())
+	newSource := client.Source{
+		ID:          id,
+		Label:       d.Get("label").(string),
+		Enabled:     d.Get("enabled").(bool),
+		BackendPath: imapEmailSourceBackendPath,
+		BackendData: string(backendData),
+	}
+
+	return &newSource
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rfleming71/terraform-provider-mayan-edms/client"
+)
+
+func resourceIndexRebuild() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIndexRebuildCreate,
+		ReadContext:   resourceIndexRebuildRead,
+		DeleteContext: resourceIndexRebuildDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"index_template": {
+				Description: "Id of the index template to rebuild. The rebuild is queued when the resource is created and runs in the background after the apply.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"triggers": {
+				Description: "Arbitrary values that rebuild the index when they change, such as the nodes of a `mayanedms_index_template_tree`.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceIndexRebuildCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	indexTemplateId := d.Get("index_template").(int)
+
+	err := c.RebuildIndexTemplate(ctx, indexTemplateId)
+	if err != nil {
+		return apiErrorDiagnostics(d, "Unable to rebuild index template", err)
+	}
+
+	d.SetId(fmt.Sprintf("%v", indexTemplateId))
+
+	return resourceIndexRebuildRead(ctx, d, m)
+}
+
+func resourceIndexRebuildRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	id, _ := strconv.Atoi(d.Id())
+
+	_, err := c.GetIndexTemplateById(ctx, id)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Index template not found, removing rebuild from state", map[string]interface{}{
+				"id": d.Id(),
+			})
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceIndexRebuildDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Rebuilding can't be undone, forgetting it is all there is to do.
+	d.SetId("")
+	return nil
+}
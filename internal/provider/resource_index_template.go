@@ -1,21 +1,30 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/rfleming71/terraform-provider-mayan-edms/client"
 )
 
 func resourceIndexTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIndexTemplateCreate,
-		Read:   resourceIndexTemplateRead,
-		Update: resourceIndexTemplateUpdate,
-		Delete: resourceIndexTemplateDelete,
+		CreateContext: resourceIndexTemplateCreate,
+		ReadContext:   resourceIndexTemplateRead,
+		UpdateContext: resourceIndexTemplateUpdate,
+		DeleteContext: resourceIndexTemplateDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceIndexTemplateImport,
+			StateContext: resourceIndexTemplateImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -42,6 +51,12 @@ func resourceIndexTemplate() *schema.Resource {
 					Type: schema.TypeInt,
 				},
 			},
+			"rebuild_on_change": {
+				Description: "Rebuild the index when its document types change, so documents of added types are indexed and those of removed types are not. Changes to the nodes of the index are covered by `rebuild_on_change` of `mayanedms_index_template_node` and `mayanedms_index_template_tree`. The rebuild runs in the background after the apply.",
+				Type:        schema.TypeBool,
+				Default:     false,
+				Optional:    true,
+			},
 			"root_node_id": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -50,103 +65,88 @@ func resourceIndexTemplate() *schema.Resource {
 	}
 }
 
-func resourceIndexTemplateCreate(d *schema.ResourceData, m interface{}) error {
+func resourceIndexTemplateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	newIndexTemplate := dataToIndexTemplate(d)
 
-	indexTemplate, err := c.CreateIndexTemplate(*newIndexTemplate)
+	indexTemplate, err := c.CreateIndexTemplate(ctx, *newIndexTemplate)
 
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(d, "Unable to create index template", err)
 	}
 
 	d.SetId(fmt.Sprintf("%v", indexTemplate.ID))
 
-	dTypes := d.Get("document_types").(*schema.Set).List()
-	documentTypes := []int{}
-	for _, tag := range dTypes {
-		t := tag.(int)
-		documentTypes = append(documentTypes, t)
-	}
-
-	for _, docType := range documentTypes {
-		_ = c.AddIndexTemplateDocumentType(indexTemplate.ID, docType)
+	diags := reconcileNewMembership(d, indexTemplateDocumentTypeMembership(ctx, c, indexTemplate.ID))
+	if d.Get("rebuild_on_change").(bool) && d.Get("document_types").(*schema.Set).Len() > 0 {
+		diags = append(diags, rebuildIndexTemplate(ctx, c, indexTemplate.ID)...)
 	}
 
-	return resourceIndexTemplateRead(d, m)
+	return append(diags, resourceIndexTemplateRead(ctx, d, m)...)
 }
 
-func resourceIndexTemplateRead(d *schema.ResourceData, m interface{}) error {
+func resourceIndexTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	id, _ := strconv.Atoi(d.Id())
 
-	source, err := c.GetIndexTemplateById(id)
+	source, err := c.GetIndexTemplateById(ctx, id)
 	if err != nil {
-		return err
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Index template not found, removing from state", map[string]interface{}{
+				"id": d.Id(),
+			})
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
 	}
 
 	err = indexTemplateToData(source, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	docTypes, err := c.GetIndexTemplateDocumentTypes(source.ID)
+	docTypes, err := c.GetIndexTemplateDocumentTypes(ctx, source.ID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("document_types", docTypes); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceIndexTemplateUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceIndexTemplateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	indexTemplate := dataToIndexTemplate(d)
 	indexTemplate.ID, _ = strconv.Atoi(d.Id())
-	_, err := c.UpdateIndexTemplate(*indexTemplate)
+	_, err := c.UpdateIndexTemplate(ctx, *indexTemplate)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(d, "Unable to update index template", err)
 	}
 
-	if d.HasChange("document_types") {
-		o, n := d.GetChange("document_types")
-
-		oTypes := o.(*schema.Set)
-		nTypes := n.(*schema.Set)
-
-		removals := oTypes.Difference(nTypes)
-		for _, removal := range removals.List() {
-			if err := c.RemoveIndexTemplateDocumentType(indexTemplate.ID, removal.(int)); err != nil {
-				return err
-			}
-		}
-
-		additions := nTypes.Difference(oTypes)
-		for _, addition := range additions.List() {
-			if err := c.AddIndexTemplateDocumentType(indexTemplate.ID, addition.(int)); err != nil {
-				return err
-			}
-		}
+	diags := reconcileMembershipChange(d, indexTemplateDocumentTypeMembership(ctx, c, indexTemplate.ID))
+	if d.Get("rebuild_on_change").(bool) && d.HasChange("document_types") {
+		diags = append(diags, rebuildIndexTemplate(ctx, c, indexTemplate.ID)...)
 	}
 
-	return resourceIndexTemplateRead(d, m)
+	return append(diags, resourceIndexTemplateRead(ctx, d, m)...)
 }
 
-func resourceIndexTemplateDelete(d *schema.ResourceData, m interface{}) error {
+func resourceIndexTemplateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	id, _ := strconv.Atoi(d.Id())
-	err := c.DeleteIndexTemplate(id)
+	err := c.DeleteIndexTemplate(ctx, id)
 	if err == nil {
 		d.SetId("")
 	}
 
-	return err
+	return diag.FromErr(err)
 }
 
-func resourceIndexTemplateImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceIndexTemplateImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	c := m.(client.MayanEdmsClient)
 	id, err := strconv.Atoi(d.Id())
 	rd := []*schema.ResourceData{d}
@@ -154,7 +154,7 @@ func resourceIndexTemplateImport(d *schema.ResourceData, m interface{}) ([]*sche
 		return rd, err
 	}
 
-	indexTemplate, err := c.GetIndexTemplateById(id)
+	indexTemplate, err := c.GetIndexTemplateById(ctx, id)
 	if err != nil {
 		return rd, err
 	}
@@ -164,7 +164,7 @@ func resourceIndexTemplateImport(d *schema.ResourceData, m interface{}) ([]*sche
 		return rd, err
 	}
 
-	docTypes, err := c.GetIndexTemplateDocumentTypes(indexTemplate.ID)
+	docTypes, err := c.GetIndexTemplateDocumentTypes(ctx, indexTemplate.ID)
 	if err != nil {
 		return rd, err
 	}
@@ -173,9 +173,44 @@ func resourceIndexTemplateImport(d *schema.ResourceData, m interface{}) ([]*sche
 		return rd, err
 	}
 
+	if err := d.Set("rebuild_on_change", false); err != nil {
+		return rd, err
+	}
+
 	return rd, err
 }
 
+func indexTemplateDocumentTypeMembership(ctx context.Context, c client.MayanEdmsClient, indexTemplateId int) membership {
+	return membership{
+		attribute: "document_types",
+		member:    "document type",
+		owner:     "index template",
+		add: func(documentTypeId interface{}) error {
+			return c.AddIndexTemplateDocumentType(ctx, indexTemplateId, documentTypeId.(int))
+		},
+		remove: func(documentTypeId interface{}) error {
+			return c.RemoveIndexTemplateDocumentType(ctx, indexTemplateId, documentTypeId.(int))
+		},
+	}
+}
+
+// rebuildIndexTemplate queues a rebuild of an index template. A failed rebuild
+// doesn't undo the changes that were applied, so it is reported as a warning.
+func rebuildIndexTemplate(ctx context.Context, c client.MayanEdmsClient, indexTemplateId int) diag.Diagnostics {
+	tflog.Debug(ctx, "Rebuilding index template", map[string]interface{}{
+		"id": indexTemplateId,
+	})
+	if err := c.RebuildIndexTemplate(ctx, indexTemplateId); err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "Unable to rebuild index template",
+			Detail:   fmt.Sprintf("The index template %v was updated but its rebuild could not be queued: %v", indexTemplateId, err),
+		}}
+	}
+
+	return nil
+}
+
 func indexTemplateToData(indexTemplate *client.IndexTemplate, d *schema.ResourceData) error {
 	d.SetId(fmt.Sprintf("%v", indexTemplate.ID))
 	if err := d.Set("label", indexTemplate.Label); err != nil {
@@ -4,27 +4,35 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/rfleming71/terraform-provider-mayan-edms/client"
 )
 
 func resourceIndexTemplateNode() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIndexTemplateNodeCreate,
-		Read:   resourceIndexTemplateNodeRead,
-		Update: resourceIndexTemplateNodeUpdate,
-		Delete: resourceIndexTemplateNodeDelete,
+		CreateContext: resourceIndexTemplateNodeCreate,
+		ReadContext:   resourceIndexTemplateNodeRead,
+		UpdateContext: resourceIndexTemplateNodeUpdate,
+		DeleteContext: resourceIndexTemplateNodeDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceIndexTemplateNodeImport,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"expression": {
-				Description: "Enter a python string expression to be evaluated",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:      "Enter a python string expression to be evaluated.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateDjangoTemplateString,
 				// Avoid issues due to trailing whitespace
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					suppressDiff := strings.TrimSpace(old) == strings.TrimSpace(new)
@@ -56,76 +64,104 @@ func resourceIndexTemplateNode() *schema.Resource {
 				Type:        schema.TypeInt,
 				Computed:    true,
 			},
+			"rebuild_on_change": {
+				Description: "Rebuild the index when the node is created, changed or removed, so documents are filed under the new expression. The rebuild runs in the background after the apply.",
+				Type:        schema.TypeBool,
+				Default:     false,
+				Optional:    true,
+			},
 		},
 	}
 }
 
-func resourceIndexTemplateNodeCreate(d *schema.ResourceData, m interface{}) error {
+func resourceIndexTemplateNodeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 
 	indexTemplateId, newIndexTemplateNode := dataToIndexTemplateNode(d)
 
-	indexTemplateNode, err := c.CreateIndexTemplateNode(*newIndexTemplateNode)
+	indexTemplateNode, err := c.CreateIndexTemplateNode(ctx, *newIndexTemplateNode)
 
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(d, "Unable to create index template node", err)
 	}
 
 	d.SetId(fmt.Sprintf("%v-%v", indexTemplateId, indexTemplateNode.ID))
 
-	return resourceIndexTemplateNodeRead(d, m)
+	var diags diag.Diagnostics
+	if d.Get("rebuild_on_change").(bool) {
+		diags = rebuildIndexTemplate(ctx, c, indexTemplateId)
+	}
+
+	return append(diags, resourceIndexTemplateNodeRead(ctx, d, m)...)
 }
 
-func resourceIndexTemplateNodeRead(d *schema.ResourceData, m interface{}) error {
+func resourceIndexTemplateNodeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	indexTemplateId, id, err := getIdInformation(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	source, err := c.GetIndexTemplateNodeById(indexTemplateId, id)
+	source, err := c.GetIndexTemplateNodeById(ctx, indexTemplateId, id)
 	if err != nil {
-		return err
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Index template node not found, removing from state", map[string]interface{}{
+				"id": d.Id(),
+			})
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
 	}
 
 	err = indexTemplateNodeToData(indexTemplateId, source, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceIndexTemplateNodeUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceIndexTemplateNodeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	_, indexTemplateNode := dataToIndexTemplateNode(d)
 	indexTemplateId, indexTemplateNodeId, err := getIdInformation(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	indexTemplateNode.ID = indexTemplateNodeId
-	_, err = c.UpdateIndexTemplateNode(indexTemplateId, *indexTemplateNode)
+	_, err = c.UpdateIndexTemplateNode(ctx, indexTemplateId, *indexTemplateNode)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(d, "Unable to update index template node", err)
+	}
+
+	var diags diag.Diagnostics
+	if d.Get("rebuild_on_change").(bool) && d.HasChangeExcept("rebuild_on_change") {
+		diags = rebuildIndexTemplate(ctx, c, indexTemplateId)
 	}
 
-	return resourceIndexTemplateNodeRead(d, m)
+	return append(diags, resourceIndexTemplateNodeRead(ctx, d, m)...)
 }
 
-func resourceIndexTemplateNodeDelete(d *schema.ResourceData, m interface{}) error {
+func resourceIndexTemplateNodeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	indexTemplateId, id, err := getIdInformation(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = c.DeleteIndexTemplateNode(indexTemplateId, id)
-	if err == nil {
-		d.SetId("")
+	err = c.DeleteIndexTemplateNode(ctx, indexTemplateId, id)
+	if err != nil {
+		return diag.FromErr(err)
 	}
+	d.SetId("")
 
-	return err
+	if d.Get("rebuild_on_change").(bool) {
+		return rebuildIndexTemplate(ctx, c, indexTemplateId)
+	}
+
+	return nil
 }
 
 func resourceIndexTemplateNodeImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
@@ -140,7 +176,7 @@ func resourceIndexTemplateNodeImport(ctx context.Context, d *schema.ResourceData
 		"index_id": indexTemplateId,
 		"id":       id,
 	})
-	indexTemplateNode, err := c.GetIndexTemplateNodeById(indexTemplateId, id)
+	indexTemplateNode, err := c.GetIndexTemplateNodeById(ctx, indexTemplateId, id)
 	if err != nil {
 		return rd, err
 	}
@@ -150,6 +186,10 @@ func resourceIndexTemplateNodeImport(ctx context.Context, d *schema.ResourceData
 		return rd, err
 	}
 
+	if err := d.Set("rebuild_on_change", false); err != nil {
+		return rd, err
+	}
+
 	return rd, err
 }
 
@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/rfleming71/terraform-provider-mayan-edms/client"
+)
+
+// fakeIndexClient serves the nodes of index template 1, whose root node is 1,
+// and records the changes made to them.
+type fakeIndexClient struct {
+	client.MayanEdmsClient
+	nodes    map[int]client.IndexTemplateNode
+	nextId   int
+	created  []string
+	updated  []int
+	deleted  []int
+	rebuilds int
+}
+
+func newFakeIndexClient(nodes ...client.IndexTemplateNode) *fakeIndexClient {
+	c := &fakeIndexClient{nodes: map[int]client.IndexTemplateNode{1: {ID: 1, IndexID: 1}}, nextId: 100}
+	for _, node := range nodes {
+		node.IndexID = 1
+		c.nodes[node.ID] = node
+	}
+
+	return c
+}
+
+func (c *fakeIndexClient) GetIndexTemplateById(ctx context.Context, id int) (*client.IndexTemplate, error) {
+	return &client.IndexTemplate{ID: id, Label: "Invoices", RootNodeID: 1}, nil
+}
+
+func (c *fakeIndexClient) RebuildIndexTemplate(ctx context.Context, id int) error {
+	c.rebuilds++
+	return nil
+}
+
+func (c *fakeIndexClient) GetIndexTemplateNodeById(ctx context.Context, indexId, nodeId int) (*client.IndexTemplateNode, error) {
+	node, ok := c.nodes[nodeId]
+	if !ok {
+		return nil, &client.APIError{StatusCode: 404}
+	}
+
+	return &node, nil
+}
+
+func (c *fakeIndexClient) GetIndexTemplateNodes(ctx context.Context, indexId int) ([]client.IndexTemplateNode, error) {
+	nodes := make([]client.IndexTemplateNode, 0, len(c.nodes))
+	for _, node := range c.nodes {
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].ID < nodes[j].ID
+	})
+
+	return nodes, nil
+}
+
+func (c *fakeIndexClient) CreateIndexTemplateNode(ctx context.Context, indexTemplateNode client.IndexTemplateNode) (*client.IndexTemplateNode, error) {
+	c.nextId++
+	indexTemplateNode.ID = c.nextId
+	c.nodes[indexTemplateNode.ID] = indexTemplateNode
+	c.created = append(c.created, indexTemplateNode.Expression)
+
+	return &indexTemplateNode, nil
+}
+
+func (c *fakeIndexClient) UpdateIndexTemplateNode(ctx context.Context, indexTemplateId int, indexTemplateNode client.IndexTemplateNode) (*client.IndexTemplateNode, error) {
+	c.nodes[indexTemplateNode.ID] = indexTemplateNode
+	c.updated = append(c.updated, indexTemplateNode.ID)
+
+	return &indexTemplateNode, nil
+}
+
+func (c *fakeIndexClient) DeleteIndexTemplateNode(ctx context.Context, indexId, nodeId int) error {
+	if _, ok := c.nodes[nodeId]; !ok {
+		return &client.APIError{StatusCode: 404}
+	}
+	delete(c.nodes, nodeId)
+	c.deleted = append(c.deleted, nodeId)
+
+	return nil
+}
+
+// planData returns the data of a resource planned from a prior state to a
+// configuration.
+func planData(t *testing.T, r *schema.Resource, state *terraform.InstanceState, raw map[string]interface{}) *schema.ResourceData {
+	t.Helper()
+
+	sm := schema.InternalMap(r.Schema)
+	diff, err := sm.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), nil, nil, true)
+	if err != nil {
+		t.Fatalf("unable to plan: %v", err)
+	}
+
+	d, err := sm.Data(state, diff)
+	if err != nil {
+		t.Fatalf("unable to read plan: %v", err)
+	}
+
+	return d
+}
+
+func TestResourceIndexTemplateNodeRebuild(t *testing.T) {
+	node := func(expression string, rebuild bool) map[string]interface{} {
+		return map[string]interface{}{
+			"index_id":          1,
+			"parent_id":         1,
+			"expression":        expression,
+			"rebuild_on_change": rebuild,
+		}
+	}
+
+	cases := []struct {
+		name     string
+		current  map[string]interface{}
+		desired  map[string]interface{}
+		rebuilds int
+	}{
+		{name: "create", desired: node("{{ document.label }}", true), rebuilds: 1},
+		{name: "create without rebuild", desired: node("{{ document.label }}", false)},
+		{name: "update", current: node("{{ document.label }}", true), desired: node("{{ document.uuid }}", true), rebuilds: 1},
+		{name: "update without rebuild", current: node("{{ document.label }}", false), desired: node("{{ document.uuid }}", false)},
+		{name: "enable rebuild only", current: node("{{ document.label }}", false), desired: node("{{ document.label }}", true)},
+		{name: "delete", current: node("{{ document.label }}", true), rebuilds: 1},
+		{name: "delete without rebuild", current: node("{{ document.label }}", false)},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newFakeIndexClient(client.IndexTemplateNode{ID: 2, ParentID: 1, Expression: "{{ document.label }}"})
+			r := resourceIndexTemplateNode()
+
+			var diags diag.Diagnostics
+			switch {
+			case tc.current == nil:
+				d := schema.TestResourceDataRaw(t, r.Schema, tc.desired)
+				diags = resourceIndexTemplateNodeCreate(context.Background(), d, c)
+			case tc.desired == nil:
+				d := schema.TestResourceDataRaw(t, r.Schema, tc.current)
+				d.SetId("1-2")
+				diags = resourceIndexTemplateNodeDelete(context.Background(), d, c)
+			default:
+				state := schema.TestResourceDataRaw(t, r.Schema, tc.current)
+				state.SetId("1-2")
+				d := planData(t, r, state.State(), tc.desired)
+				diags = resourceIndexTemplateNodeUpdate(context.Background(), d, c)
+			}
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if c.rebuilds != tc.rebuilds {
+				t.Errorf("expected %v rebuilds, got %v", tc.rebuilds, c.rebuilds)
+			}
+		})
+	}
+}
@@ -0,0 +1,480 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rfleming71/terraform-provider-mayan-edms/client"
+)
+
+// indexTreeNode is a node of the index template tree resource, with its
+// parent referred to by key.
+type indexTreeNode struct {
+	key           string
+	parent        string
+	expression    string
+	enabled       bool
+	linkDocuments bool
+}
+
+func resourceIndexTemplateTree() *schema.Resource {
+	nodeSchema := resourceIndexTemplateNode().Schema
+
+	return &schema.Resource{
+		CreateContext: resourceIndexTemplateTreeCreate,
+		ReadContext:   resourceIndexTemplateTreeRead,
+		UpdateContext: resourceIndexTemplateTreeUpdate,
+		DeleteContext: resourceIndexTemplateTreeDelete,
+		CustomizeDiff: resourceIndexTemplateTreeCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIndexTemplateTreeImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"index_template": {
+				Description: "Id of the index template the tree belongs to. The tree takes ownership of every node of the index template: existing nodes are kept when a declared node has the same parent and expression, and the others are removed. Don't combine with `mayanedms_index_template_node` on the same index template.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"node": {
+				Description: "Nodes of the tree. Nodes keep their id when their expression, options or parent change.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Description: "Unique name of the node within the tree, used by `parent` to refer to it.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"parent": {
+							Description: "Key of the parent node. Leave empty for a node directly under the root of the index.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "",
+						},
+						"expression":     nodeSchema["expression"],
+						"enabled":        nodeSchema["enabled"],
+						"link_documents": nodeSchema["link_documents"],
+					},
+				},
+			},
+			"rebuild_on_change": {
+				Description: "Rebuild the index when nodes of the tree are created, changed or removed, so documents are filed under the new expressions. The rebuild runs in the background after the apply.",
+				Type:        schema.TypeBool,
+				Default:     false,
+				Optional:    true,
+			},
+			"node_ids": {
+				Description: "Ids of the nodes keyed by `key`.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+		},
+	}
+}
+
+func resourceIndexTemplateTreeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	indexTemplateId := d.Get("index_template").(int)
+
+	d.SetId(fmt.Sprintf("%v", indexTemplateId))
+
+	diags := reconcileIndexTemplateTree(ctx, c, indexTemplateId, d)
+	if d.Get("rebuild_on_change").(bool) {
+		diags = append(diags, rebuildIndexTemplate(ctx, c, indexTemplateId)...)
+	}
+
+	return append(diags, resourceIndexTemplateTreeRead(ctx, d, m)...)
+}
+
+func resourceIndexTemplateTreeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	id, _ := strconv.Atoi(d.Id())
+
+	err := indexTemplateTreeToData(ctx, c, id, d)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Index template not found, removing tree from state", map[string]interface{}{
+				"id": d.Id(),
+			})
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceIndexTemplateTreeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	id, _ := strconv.Atoi(d.Id())
+
+	diags := reconcileIndexTemplateTree(ctx, c, id, d)
+	if d.Get("rebuild_on_change").(bool) && d.HasChange("node") {
+		diags = append(diags, rebuildIndexTemplate(ctx, c, id)...)
+	}
+
+	return append(diags, resourceIndexTemplateTreeRead(ctx, d, m)...)
+}
+
+func resourceIndexTemplateTreeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	id, _ := strconv.Atoi(d.Id())
+
+	// Removing a node removes its children as well, so nodes that are already
+	// gone are not an error.
+	for _, nodeId := range d.Get("node_ids").(map[string]interface{}) {
+		err := c.DeleteIndexTemplateNode(ctx, id, nodeId.(int))
+		if err != nil && !client.IsNotFound(err) {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+
+	if d.Get("rebuild_on_change").(bool) {
+		return rebuildIndexTemplate(ctx, c, id)
+	}
+
+	return nil
+}
+
+func resourceIndexTemplateTreeImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(client.MayanEdmsClient)
+	id, err := strconv.Atoi(d.Id())
+	rd := []*schema.ResourceData{d}
+	if err != nil {
+		return rd, err
+	}
+
+	err = indexTemplateTreeToData(ctx, c, id, d)
+	if err != nil {
+		return rd, err
+	}
+
+	err = d.Set("rebuild_on_change", false)
+	return rd, err
+}
+
+func resourceIndexTemplateTreeCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	nodes := dataToIndexTreeNodes(d.Get("node").([]interface{}))
+	for _, node := range nodes {
+		if node.key == "" {
+			return nil
+		}
+	}
+
+	if _, err := sortIndexTreeNodes(nodes); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// reconcileIndexTemplateTree brings the nodes of an index template in line
+// with the configuration. Nodes are matched to the server by the ids recorded
+// in node_ids, then by parent and expression, so nodes that exist before the
+// tree is created or imported are kept. Nodes are processed parents first, so
+// a node can be moved under a node that is created in the same run. Nodes that
+// are no longer declared are removed last, once every declared node has been
+// moved out of them.
+func reconcileIndexTemplateTree(ctx context.Context, c client.MayanEdmsClient, indexTemplateId int, d *schema.ResourceData) diag.Diagnostics {
+	indexTemplate, err := c.GetIndexTemplateById(ctx, indexTemplateId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	serverNodes, err := c.GetIndexTemplateNodes(ctx, indexTemplateId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	nodes, err := sortIndexTreeNodes(dataToIndexTreeNodes(d.Get("node").([]interface{})))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	existing := map[int]client.IndexTemplateNode{}
+	for _, node := range serverNodes {
+		existing[node.ID] = node
+	}
+
+	var failures []string
+	ids := map[string]interface{}{}
+	kept := map[int]bool{}
+	recorded := d.Get("node_ids").(map[string]interface{})
+
+	// Nodes recorded for a declared key are not matched to another key.
+	reserved := map[int]bool{}
+	managed := map[int]bool{}
+	for key, id := range recorded {
+		managed[id.(int)] = true
+		for _, node := range nodes {
+			if node.key == key {
+				reserved[id.(int)] = true
+			}
+		}
+	}
+
+	for _, node := range nodes {
+		parentId := indexTemplate.RootNodeID
+		if node.parent != "" {
+			id, ok := ids[node.parent]
+			if !ok {
+				failures = append(failures, fmt.Sprintf("node %q skipped, its parent %q is missing", node.key, node.parent))
+				continue
+			}
+			parentId = id.(int)
+		}
+
+		desired := client.IndexTemplateNode{
+			Expression:    node.expression,
+			Enabled:       node.enabled,
+			LinkDocuments: node.linkDocuments,
+			IndexID:       indexTemplateId,
+			Parent:        parentId,
+			ParentID:      parentId,
+		}
+
+		id, ok := recorded[node.key].(int)
+		if _, found := existing[id]; !ok || !found || id == indexTemplate.RootNodeID {
+			id, ok = matchIndexTreeNode(serverNodes, desired, func(id int) bool {
+				return kept[id] || reserved[id] || id == indexTemplate.RootNodeID
+			})
+		}
+
+		if ok {
+			if current, ok := existing[id]; ok {
+				ids[node.key] = id
+				kept[id] = true
+				if strings.TrimSpace(current.Expression) == strings.TrimSpace(desired.Expression) &&
+					current.Enabled == desired.Enabled &&
+					current.LinkDocuments == desired.LinkDocuments &&
+					current.ParentID == desired.ParentID {
+					continue
+				}
+
+				desired.ID = id
+				if _, err := c.UpdateIndexTemplateNode(ctx, indexTemplateId, desired); err != nil {
+					failures = append(failures, fmt.Sprintf("update node %q: %v", node.key, err))
+				}
+				continue
+			}
+		}
+
+		created, err := c.CreateIndexTemplateNode(ctx, desired)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("create node %q: %v", node.key, err))
+			continue
+		}
+		ids[node.key] = created.ID
+		kept[created.ID] = true
+	}
+
+	var diags diag.Diagnostics
+	for _, node := range serverNodes {
+		if node.ID == indexTemplate.RootNodeID || kept[node.ID] {
+			continue
+		}
+
+		err := c.DeleteIndexTemplateNode(ctx, indexTemplateId, node.ID)
+		if err != nil && !client.IsNotFound(err) {
+			failures = append(failures, fmt.Sprintf("remove node %v: %v", node.ID, err))
+			continue
+		}
+
+		if !managed[node.ID] {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Warning,
+				Summary:       "Removed a node that is not declared in the tree",
+				Detail:        fmt.Sprintf("Node %v with expression %q of index template %v was not declared in the tree and has been removed.", node.ID, strings.TrimSpace(node.Expression), indexTemplateId),
+				AttributePath: cty.GetAttrPath("node"),
+			})
+		}
+	}
+
+	if err := d.Set("node_ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(failures) == 0 {
+		return diags
+	}
+
+	return append(diags, diag.Diagnostic{
+		Severity:      diag.Error,
+		Summary:       "Unable to update nodes of index template",
+		Detail:        fmt.Sprintf("%v changes failed:\n\n%v", len(failures), strings.Join(failures, "\n")),
+		AttributePath: cty.GetAttrPath("node"),
+	})
+}
+
+// matchIndexTreeNode finds a server node under the same parent and with the
+// same expression as a declared node, skipping the nodes already taken.
+func matchIndexTreeNode(serverNodes []client.IndexTemplateNode, desired client.IndexTemplateNode, taken func(id int) bool) (int, bool) {
+	for _, node := range serverNodes {
+		if !taken(node.ID) && node.ParentID == desired.ParentID &&
+			strings.TrimSpace(node.Expression) == strings.TrimSpace(desired.Expression) {
+			return node.ID, true
+		}
+	}
+
+	return 0, false
+}
+
+// indexTemplateTreeToData sets the nodes read from the server. Nodes are named
+// by the keys recorded in node_ids; nodes created outside of Terraform are
+// named "node_<id>".
+func indexTemplateTreeToData(ctx context.Context, c client.MayanEdmsClient, indexTemplateId int, d *schema.ResourceData) error {
+	indexTemplate, err := c.GetIndexTemplateById(ctx, indexTemplateId)
+	if err != nil {
+		return err
+	}
+
+	serverNodes, err := c.GetIndexTemplateNodes(ctx, indexTemplateId)
+	if err != nil {
+		return err
+	}
+
+	keys := map[int]string{}
+	for key, id := range d.Get("node_ids").(map[string]interface{}) {
+		keys[id.(int)] = key
+	}
+
+	keyOf := func(id int) string {
+		if key, ok := keys[id]; ok {
+			return key
+		}
+		return fmt.Sprintf("node_%v", id)
+	}
+
+	order := map[string]int{}
+	for i, n := range d.Get("node").([]interface{}) {
+		order[n.(map[string]interface{})["key"].(string)] = i
+	}
+
+	var nodes []client.IndexTemplateNode
+	for _, node := range serverNodes {
+		if node.ID != indexTemplate.RootNodeID {
+			nodes = append(nodes, node)
+		}
+	}
+
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return configuredBefore(order, keyOf(nodes[i].ID), nodes[i].ID, keyOf(nodes[j].ID), nodes[j].ID)
+	})
+
+	ids := map[string]interface{}{}
+	nodeList := make([]interface{}, 0, len(nodes))
+	for _, node := range nodes {
+		parent := ""
+		if node.ParentID != indexTemplate.RootNodeID {
+			parent = keyOf(node.ParentID)
+		}
+
+		ids[keyOf(node.ID)] = node.ID
+		nodeList = append(nodeList, map[string]interface{}{
+			"key":            keyOf(node.ID),
+			"parent":         parent,
+			"expression":     node.Expression,
+			"enabled":        node.Enabled,
+			"link_documents": node.LinkDocuments,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%v", indexTemplate.ID))
+	if err := d.Set("index_template", indexTemplate.ID); err != nil {
+		return err
+	}
+
+	if err := d.Set("node", nodeList); err != nil {
+		return err
+	}
+
+	if err := d.Set("node_ids", ids); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// sortIndexTreeNodes orders nodes so that every node comes after its parent,
+// rejecting duplicated keys, unknown parents and cycles.
+func sortIndexTreeNodes(nodes []indexTreeNode) ([]indexTreeNode, error) {
+	byKey := map[string]indexTreeNode{}
+	for _, node := range nodes {
+		if _, ok := byKey[node.key]; ok {
+			return nil, fmt.Errorf("node %q is declared more than once", node.key)
+		}
+		byKey[node.key] = node
+	}
+
+	for _, node := range nodes {
+		if node.parent != "" {
+			if _, ok := byKey[node.parent]; !ok {
+				return nil, fmt.Errorf("node %q refers to parent %q, which is not declared", node.key, node.parent)
+			}
+		}
+	}
+
+	sorted := make([]indexTreeNode, 0, len(nodes))
+	placed := map[string]bool{}
+	for len(sorted) < len(nodes) {
+		progress := false
+		for _, node := range nodes {
+			if placed[node.key] || (node.parent != "" && !placed[node.parent]) {
+				continue
+			}
+			sorted = append(sorted, node)
+			placed[node.key] = true
+			progress = true
+		}
+
+		if !progress {
+			var cycle []string
+			for _, node := range nodes {
+				if !placed[node.key] {
+					cycle = append(cycle, node.key)
+				}
+			}
+			return nil, fmt.Errorf("nodes %q are their own ancestors", cycle)
+		}
+	}
+
+	return sorted, nil
+}
+
+func dataToIndexTreeNodes(nodes []interface{}) []indexTreeNode {
+	result := make([]indexTreeNode, 0, len(nodes))
+	for _, n := range nodes {
+		node := n.(map[string]interface{})
+		result = append(result, indexTreeNode{
+			key:           node["key"].(string),
+			parent:        node["parent"].(string),
+			expression:    node["expression"].(string),
+			enabled:       node["enabled"].(bool),
+			linkDocuments: node["link_documents"].(bool),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,347 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rfleming71/terraform-provider-mayan-edms/client"
+)
+
+// treeConfig returns the raw configuration of a tree of index template 1
+// holding the given nodes, each a key, parent and expression.
+func treeConfig(rebuild bool, nodes ...[3]string) map[string]interface{} {
+	items := make([]interface{}, len(nodes))
+	for i, node := range nodes {
+		items[i] = map[string]interface{}{
+			"key":        node[0],
+			"parent":     node[1],
+			"expression": node[2],
+		}
+	}
+
+	return map[string]interface{}{
+		"index_template":    1,
+		"rebuild_on_change": rebuild,
+		"node":              items,
+	}
+}
+
+func TestResourceIndexTemplateTreeRebuild(t *testing.T) {
+	year := [3]string{"year", "", "{{ document.date_added.year }}"}
+	month := [3]string{"month", "year", "{{ document.date_added.month }}"}
+
+	cases := []struct {
+		name     string
+		current  map[string]interface{}
+		desired  map[string]interface{}
+		rebuilds int
+	}{
+		{name: "create", desired: treeConfig(true, year), rebuilds: 1},
+		{name: "create without rebuild", desired: treeConfig(false, year)},
+		{name: "update", current: treeConfig(true, year), desired: treeConfig(true, year, month), rebuilds: 1},
+		{name: "update without rebuild", current: treeConfig(false, year), desired: treeConfig(false, year, month)},
+		{name: "enable rebuild only", current: treeConfig(false, year), desired: treeConfig(true, year)},
+		{name: "delete", current: treeConfig(true, year), rebuilds: 1},
+		{name: "delete without rebuild", current: treeConfig(false, year)},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newFakeIndexClient(client.IndexTemplateNode{ID: 2, ParentID: 1, Expression: year[2]})
+			r := resourceIndexTemplateTree()
+
+			var diags diag.Diagnostics
+			switch {
+			case tc.current == nil:
+				d := schema.TestResourceDataRaw(t, r.Schema, tc.desired)
+				diags = resourceIndexTemplateTreeCreate(context.Background(), d, c)
+			default:
+				state := schema.TestResourceDataRaw(t, r.Schema, tc.current)
+				state.SetId("1")
+				if err := state.Set("node_ids", map[string]interface{}{"year": 2}); err != nil {
+					t.Fatalf("unable to set node_ids: %v", err)
+				}
+				if tc.desired == nil {
+					diags = resourceIndexTemplateTreeDelete(context.Background(), state, c)
+					break
+				}
+				d := planData(t, r, state.State(), tc.desired)
+				diags = resourceIndexTemplateTreeUpdate(context.Background(), d, c)
+			}
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if c.rebuilds != tc.rebuilds {
+				t.Errorf("expected %v rebuilds, got %v", tc.rebuilds, c.rebuilds)
+			}
+		})
+	}
+}
+
+func TestMatchIndexTreeNode(t *testing.T) {
+	serverNodes := []client.IndexTemplateNode{
+		{ID: 1},
+		{ID: 2, ParentID: 1, Expression: "{{ document.label }}"},
+		{ID: 3, ParentID: 1, Expression: "{{ document.label }}\n"},
+		{ID: 4, ParentID: 2, Expression: "{{ document.uuid }}"},
+	}
+
+	cases := []struct {
+		name       string
+		desired    client.IndexTemplateNode
+		taken      []int
+		expectedId int
+		found      bool
+	}{
+		{name: "same parent and expression", desired: client.IndexTemplateNode{ParentID: 1, Expression: "{{ document.label }}"}, expectedId: 2, found: true},
+		{name: "surrounding whitespace", desired: client.IndexTemplateNode{ParentID: 2, Expression: " {{ document.uuid }} "}, expectedId: 4, found: true},
+		{name: "duplicate skips taken", desired: client.IndexTemplateNode{ParentID: 1, Expression: "{{ document.label }}"}, taken: []int{2}, expectedId: 3, found: true},
+		{name: "every duplicate taken", desired: client.IndexTemplateNode{ParentID: 1, Expression: "{{ document.label }}"}, taken: []int{2, 3}},
+		{name: "other parent", desired: client.IndexTemplateNode{ParentID: 1, Expression: "{{ document.uuid }}"}},
+		{name: "other expression", desired: client.IndexTemplateNode{ParentID: 2, Expression: "{{ document.label }}"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			taken := map[int]bool{}
+			for _, id := range tc.taken {
+				taken[id] = true
+			}
+
+			id, found := matchIndexTreeNode(serverNodes, tc.desired, func(id int) bool {
+				return taken[id]
+			})
+			if found != tc.found || id != tc.expectedId {
+				t.Errorf("expected %v %v, got %v %v", tc.expectedId, tc.found, id, found)
+			}
+		})
+	}
+}
+
+func TestSortIndexTreeNodes(t *testing.T) {
+	cases := []struct {
+		name     string
+		nodes    []indexTreeNode
+		expected []string
+		err      string
+	}{
+		{
+			name:     "already sorted",
+			nodes:    []indexTreeNode{{key: "year"}, {key: "month", parent: "year"}},
+			expected: []string{"year", "month"},
+		},
+		{
+			name:     "children before parents",
+			nodes:    []indexTreeNode{{key: "day", parent: "month"}, {key: "month", parent: "year"}, {key: "year"}, {key: "label"}},
+			expected: []string{"year", "label", "month", "day"},
+		},
+		{
+			name: "no nodes",
+		},
+		{
+			name:  "duplicated key",
+			nodes: []indexTreeNode{{key: "year"}, {key: "year"}},
+			err:   `node "year" is declared more than once`,
+		},
+		{
+			name:  "unknown parent",
+			nodes: []indexTreeNode{{key: "month", parent: "year"}},
+			err:   `node "month" refers to parent "year", which is not declared`,
+		},
+		{
+			name:  "cycle",
+			nodes: []indexTreeNode{{key: "year"}, {key: "a", parent: "b"}, {key: "b", parent: "a"}},
+			err:   `nodes ["a" "b"] are their own ancestors`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			sorted, err := sortIndexTreeNodes(tc.nodes)
+
+			if tc.err == "" && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tc.err != "" {
+				if err == nil || err.Error() != tc.err {
+					t.Fatalf("expected error %q, got %v", tc.err, err)
+				}
+				return
+			}
+
+			keys := []string{}
+			for _, node := range sorted {
+				keys = append(keys, node.key)
+			}
+			if strings.Join(keys, ",") != strings.Join(tc.expected, ",") {
+				t.Errorf("expected order %v, got %v", tc.expected, keys)
+			}
+		})
+	}
+}
+
+// indexNode returns an enabled server node of index template 1.
+func indexNode(id int, parentId int, expression string) client.IndexTemplateNode {
+	return client.IndexTemplateNode{ID: id, ParentID: parentId, Expression: expression, Enabled: true}
+}
+
+func TestReconcileIndexTemplateTree(t *testing.T) {
+	year := [3]string{"year", "", "{{ document.date_added.year }}"}
+	month := [3]string{"month", "year", "{{ document.date_added.month }}"}
+	label := [3]string{"label", "", "{{ document.label }}"}
+
+	cases := []struct {
+		name     string
+		server   []client.IndexTemplateNode
+		recorded map[string]interface{}
+		config   map[string]interface{}
+		ids      map[string]int
+		parents  map[int]int
+		created  []string
+		updated  []int
+		deleted  []int
+		warnings int
+	}{
+		{
+			name: "adopts existing nodes",
+			server: []client.IndexTemplateNode{
+				indexNode(2, 1, year[2]),
+				indexNode(3, 2, month[2]+"\n"),
+			},
+			config: treeConfig(false, year, month),
+			ids:    map[string]int{"year": 2, "month": 3},
+		},
+		{
+			name: "creates missing nodes under adopted ones",
+			server: []client.IndexTemplateNode{
+				indexNode(2, 1, year[2]),
+			},
+			config:  treeConfig(false, month, year),
+			ids:     map[string]int{"year": 2, "month": 101},
+			parents: map[int]int{101: 2},
+			created: []string{month[2]},
+		},
+		{
+			name: "duplicate expressions under one parent",
+			server: []client.IndexTemplateNode{
+				indexNode(2, 1, label[2]),
+				indexNode(3, 1, label[2]),
+			},
+			config: treeConfig(false, label, [3]string{"copy", "", label[2]}),
+			ids:    map[string]int{"label": 2, "copy": 3},
+		},
+		{
+			name: "extra duplicate is removed",
+			server: []client.IndexTemplateNode{
+				indexNode(2, 1, label[2]),
+				indexNode(3, 1, label[2]),
+			},
+			config:   treeConfig(false, label),
+			ids:      map[string]int{"label": 2},
+			deleted:  []int{3},
+			warnings: 1,
+		},
+		{
+			name: "recorded ids are not adopted by another key",
+			server: []client.IndexTemplateNode{
+				indexNode(2, 1, label[2]),
+			},
+			recorded: map[string]interface{}{"copy": 2},
+			config:   treeConfig(false, label, [3]string{"copy", "", label[2]}),
+			ids:      map[string]int{"label": 101, "copy": 2},
+			created:  []string{label[2]},
+		},
+		{
+			name: "reorders nodes",
+			server: []client.IndexTemplateNode{
+				indexNode(2, 1, year[2]),
+				indexNode(3, 2, month[2]),
+			},
+			recorded: map[string]interface{}{"year": 2, "month": 3},
+			config:   treeConfig(false, [3]string{"year", "month", year[2]}, [3]string{"month", "", month[2]}),
+			ids:      map[string]int{"year": 2, "month": 3},
+			parents:  map[int]int{2: 3, 3: 1},
+			updated:  []int{3, 2},
+		},
+		{
+			name: "changes the expression of a recorded node",
+			server: []client.IndexTemplateNode{
+				indexNode(2, 1, year[2]),
+			},
+			recorded: map[string]interface{}{"year": 2},
+			config:   treeConfig(false, [3]string{"year", "", "{{ document.date_added.year }}-"}),
+			ids:      map[string]int{"year": 2},
+			updated:  []int{2},
+		},
+		{
+			name: "deletes unmanaged nodes",
+			server: []client.IndexTemplateNode{
+				indexNode(2, 1, year[2]),
+				indexNode(3, 1, label[2]),
+				{ID: 4, ParentID: 1, Expression: "{{ document.uuid }}"},
+			},
+			recorded: map[string]interface{}{"year": 2, "label": 3},
+			config:   treeConfig(false, year),
+			ids:      map[string]int{"year": 2},
+			deleted:  []int{3, 4},
+			warnings: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newFakeIndexClient(tc.server...)
+			d := schema.TestResourceDataRaw(t, resourceIndexTemplateTree().Schema, tc.config)
+			d.SetId("1")
+			if tc.recorded != nil {
+				if err := d.Set("node_ids", tc.recorded); err != nil {
+					t.Fatalf("unable to set node_ids: %v", err)
+				}
+			}
+
+			diags := reconcileIndexTemplateTree(context.Background(), c, 1, d)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if len(diags) != tc.warnings {
+				t.Errorf("expected %v warnings, got %v", tc.warnings, diags)
+			}
+			for _, warning := range diags {
+				if warning.Summary != "Removed a node that is not declared in the tree" {
+					t.Errorf("unexpected warning %v", warning.Summary)
+				}
+			}
+
+			ids := map[string]int{}
+			for key, id := range d.Get("node_ids").(map[string]interface{}) {
+				ids[key] = id.(int)
+			}
+			if !reflect.DeepEqual(ids, tc.ids) {
+				t.Errorf("expected node_ids %v, got %v", tc.ids, ids)
+			}
+
+			if strings.Join(c.created, ",") != strings.Join(tc.created, ",") {
+				t.Errorf("expected created nodes %q, got %q", tc.created, c.created)
+			}
+			// Parents are updated before their children.
+			if fmt.Sprint(c.updated) != fmt.Sprint(tc.updated) {
+				t.Errorf("expected updated nodes %v, got %v", tc.updated, c.updated)
+			}
+			if fmt.Sprint(c.deleted) != fmt.Sprint(tc.deleted) {
+				t.Errorf("expected deleted nodes %v, got %v", tc.deleted, c.deleted)
+			}
+			for id, parent := range tc.parents {
+				if c.nodes[id].ParentID != parent {
+					t.Errorf("expected node %v under %v, got %v", id, parent, c.nodes[id].ParentID)
+				}
+			}
+		})
+	}
+}
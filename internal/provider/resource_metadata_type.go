@@ -1,21 +1,30 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/rfleming71/terraform-provider-mayan-edms/client"
 )
 
 func resourceMetadataType() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceMetadataTypeCreate,
-		Read:   resourceMetadataTypeRead,
-		Update: resourceMetadataTypeUpdate,
-		Delete: resourceMetadataTypeDelete,
+		CreateContext: resourceMetadataTypeCreate,
+		ReadContext:   resourceMetadataTypeRead,
+		UpdateContext: resourceMetadataTypeUpdate,
+		DeleteContext: resourceMetadataTypeDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceMetadataTypeImport,
+			StateContext: resourceMetadataTypeImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -55,57 +64,64 @@ func resourceMetadataType() *schema.Resource {
 	}
 }
 
-func resourceMetadataTypeCreate(d *schema.ResourceData, m interface{}) error {
+func resourceMetadataTypeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	newMetadataType := dataToMetadataType(d)
 
-	metadataType, err := c.CreateMetadataType(*newMetadataType)
+	metadataType, err := c.CreateMetadataType(ctx, *newMetadataType)
 
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(d, "Unable to create metadata type", err)
 	}
 
 	d.SetId(fmt.Sprintf("%v", metadataType.ID))
 
-	return resourceMetadataTypeRead(d, m)
+	return resourceMetadataTypeRead(ctx, d, m)
 }
 
-func resourceMetadataTypeRead(d *schema.ResourceData, m interface{}) error {
+func resourceMetadataTypeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	id, _ := strconv.Atoi(d.Id())
 
-	source, err := c.GetMetadataTypeById(id)
+	source, err := c.GetMetadataTypeById(ctx, id)
 	if err != nil {
-		return err
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Metadata type not found, removing from state", map[string]interface{}{
+				"id": d.Id(),
+			})
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
 	}
 
-	return metadataTypeToData(source, d)
+	return diag.FromErr(metadataTypeToData(source, d))
 }
 
-func resourceMetadataTypeUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceMetadataTypeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	metadataType := dataToMetadataType(d)
 	metadataType.ID, _ = strconv.Atoi(d.Id())
-	_, err := c.UpdateMetadataType(*metadataType)
+	_, err := c.UpdateMetadataType(ctx, *metadataType)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(d, "Unable to update metadata type", err)
 	}
 
-	return resourceMetadataTypeRead(d, m)
+	return resourceMetadataTypeRead(ctx, d, m)
 }
 
-func resourceMetadataTypeDelete(d *schema.ResourceData, m interface{}) error {
+func resourceMetadataTypeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	id, _ := strconv.Atoi(d.Id())
-	err := c.DeleteMetadataType(id)
+	err := c.DeleteMetadataType(ctx, id)
 	if err == nil {
 		d.SetId("")
 	}
 
-	return err
+	return diag.FromErr(err)
 }
 
-func resourceMetadataTypeImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceMetadataTypeImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	c := m.(client.MayanEdmsClient)
 	id, err := strconv.Atoi(d.Id())
 	rd := []*schema.ResourceData{d}
@@ -113,7 +129,7 @@ func resourceMetadataTypeImport(d *schema.ResourceData, m interface{}) ([]*schem
 		return rd, err
 	}
 
-	metadataType, err := c.GetMetadataTypeById(id)
+	metadataType, err := c.GetMetadataTypeById(ctx, id)
 	if err != nil {
 		return rd, err
 	}
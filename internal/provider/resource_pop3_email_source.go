@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rfleming71/terraform-provider-mayan-edms/client"
+)
+
+const pop3EmailSourceBackendPath = "mayan.apps.sources.source_backends.email_backends.SourceBackendPOP3Email"
+
+type pop3EmailSourceBackendDataType struct {
+	emailSourceBackendDataType
+	Timeout int `json:"timeout"`
+}
+
+func resourcePop3EmailSource() *schema.Resource {
+	s := emailSourceSchema(995)
+	s["timeout"] = &schema.Schema{
+		Description:  "Seconds to wait for the mail server to answer.",
+		Type:         schema.TypeInt,
+		Default:      60,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+	}
+
+	return &schema.Resource{
+		CreateContext: resourcePop3EmailSourceCreate,
+		ReadContext:   resourcePop3EmailSourceRead,
+		UpdateContext: resourcePop3EmailSourceUpdate,
+		DeleteContext: resourcePop3EmailSourceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePop3EmailSourceImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: s,
+	}
+}
+
+func resourcePop3EmailSourceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	newSource := dataToPop3EmailSource(d)
+
+	source, err := c.CreateSource(ctx, *newSource)
+
+	if err != nil {
+		return apiErrorDiagnostics(d, "Unable to create POP3 email source", err)
+	}
+
+	d.SetId(fmt.Sprintf("%v", source.ID))
+
+	return resourcePop3EmailSourceRead(ctx, d, m)
+}
+
+func resourcePop3EmailSourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	id, _ := strconv.Atoi(d.Id())
+
+	source, err := c.GetSourceById(ctx, id)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "POP3 email source not found, removing from state", map[string]interface{}{
+				"id": d.Id(),
+			})
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(pop3EmailSourceToData(source, d))
+}
+
+func resourcePop3EmailSourceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	source := dataToPop3EmailSource(d)
+	source.ID, _ = strconv.Atoi(d.Id())
+	_, err := c.UpdateSource(ctx, *source)
+	if err != nil {
+		return apiErrorDiagnostics(d, "Unable to update POP3 email source", err)
+	}
+
+	return resourcePop3EmailSourceRead(ctx, d, m)
+}
+
+func resourcePop3EmailSourceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	id, _ := strconv.Atoi(d.Id())
+	err := c.DeleteSource(ctx, id)
+	if err == nil {
+		d.SetId("")
+	}
+
+	return diag.FromErr(err)
+}
+
+func resourcePop3EmailSourceImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(client.MayanEdmsClient)
+	id, err := strconv.Atoi(d.Id())
+	rd := []*schema.ResourceData{d}
+	if err != nil {
+		return rd, err
+	}
+
+	source, err := c.GetSourceById(ctx, id)
+	if err != nil {
+		return rd, err
+	}
+
+	if source.BackendPath != pop3EmailSourceBackendPath {
+		return rd, errors.New("identified source is not of type POP3 email")
+	}
+
+	err = pop3EmailSourceToData(source, d)
+	return rd, err
+}
+
+func pop3EmailSourceToData(source *client.Source, d *schema.ResourceData) error {
+	d.SetId(fmt.Sprintf("%v", source.ID))
+	if err := d.Set("label", source.Label); err != nil {
+		return err
+	}
+	if err := d.Set("enabled", source.Enabled); err != nil {
+		return err
+	}
+
+	var backendData pop3EmailSourceBackendDataType
+	_ = json.Unmarshal([]byte(source.BackendData), &backendData)
+
+	if err := emailSourceToData(backendData.emailSourceBackendDataType, d); err != nil {
+		return err
+	}
+
+	if err := d.Set("timeout", backendData.Timeout); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func dataToPop3EmailSource(d *schema.ResourceData) *client.Source {
+	backendData, _ := json.Marshal(pop3EmailSourceBackendDataType{
+		emailSourceBackendDataType: dataToEmailSourceBackendData(d),
+		Timeout:                    d.Get("timeout").(int),
+	})
+	id, _ := strconv.Atoi(d.Id())
+	newSource := client.Source{
+		ID:          id,
+		Label:       d.Get("label").(string),
+		Enabled:     d.Get("enabled").(bool),
+		BackendPath: pop3EmailSourceBackendPath,
+		BackendData: string(backendData),
+	}
+
+	return &newSource
+}
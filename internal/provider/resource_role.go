@@ -1,21 +1,30 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/rfleming71/terraform-provider-mayan-edms/client"
 )
 
 func resourceRole() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceRoleCreate,
-		Read:   resourceRoleRead,
-		Update: resourceRoleUpdate,
-		Delete: resourceRoleDelete,
+		CreateContext: resourceRoleCreate,
+		ReadContext:   resourceRoleRead,
+		UpdateContext: resourceRoleUpdate,
+		DeleteContext: resourceRoleDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceRoleImport,
+			StateContext: resourceRoleImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -43,126 +52,88 @@ func resourceRole() *schema.Resource {
 	}
 }
 
-func resourceRoleCreate(d *schema.ResourceData, m interface{}) error {
+func resourceRoleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	newRole := dataToRole(d)
 
-	role, err := c.CreateRole(*newRole)
+	role, err := c.CreateRole(ctx, *newRole)
 
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(d, "Unable to create role", err)
 	}
 
 	d.SetId(fmt.Sprintf("%v", role.ID))
-	groups := d.Get("groups").(*schema.Set).List()
-	for _, group := range groups {
-		_ = c.AddRoleGroup(newRole.ID, group.(int))
-	}
 
-	permissions := d.Get("permissions").(*schema.Set).List()
-	for _, permission := range permissions {
-		_ = c.AddRolePermission(newRole.ID, permission.(string))
-	}
+	diags := reconcileNewMembership(d, roleGroupMembership(ctx, c, role.ID))
+	diags = append(diags, reconcileNewMembership(d, rolePermissionMembership(ctx, c, role.ID))...)
 
-	return resourceRoleRead(d, m)
+	return append(diags, resourceRoleRead(ctx, d, m)...)
 }
 
-func resourceRoleRead(d *schema.ResourceData, m interface{}) error {
+func resourceRoleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	id, _ := strconv.Atoi(d.Id())
 
-	source, err := c.GetRoleById(id)
+	source, err := c.GetRoleById(ctx, id)
 	if err != nil {
-		return err
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Role not found, removing from state", map[string]interface{}{
+				"id": d.Id(),
+			})
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
 	}
 
-	groups, err := c.GetRoleGroups(source.ID)
+	groups, err := c.GetRoleGroups(ctx, source.ID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("groups", groups); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	permissions, err := c.GetRolePermissions(source.ID)
+	permissions, err := c.GetRolePermissions(ctx, source.ID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("permissions", permissions); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return roleToData(source, d)
+	return diag.FromErr(roleToData(source, d))
 }
 
-func resourceRoleUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceRoleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	role := dataToRole(d)
 	role.ID, _ = strconv.Atoi(d.Id())
-	_, err := c.UpdateRole(*role)
+	_, err := c.UpdateRole(ctx, *role)
 	if err != nil {
-		return err
-	}
-
-	if d.HasChange("groups") {
-		o, n := d.GetChange("groups")
-
-		oTypes := o.(*schema.Set)
-		nTypes := n.(*schema.Set)
-
-		removals := oTypes.Difference(nTypes)
-		for _, removal := range removals.List() {
-			if err := c.RemoveRoleGroup(role.ID, removal.(int)); err != nil {
-				return err
-			}
-		}
-
-		additions := nTypes.Difference(oTypes)
-		for _, addition := range additions.List() {
-			if err := c.AddRoleGroup(role.ID, addition.(int)); err != nil {
-				return err
-			}
-		}
+		return apiErrorDiagnostics(d, "Unable to update role", err)
 	}
 
-	if d.HasChange("permissions") {
-		o, n := d.GetChange("permissions")
-
-		oTypes := o.(*schema.Set)
-		nTypes := n.(*schema.Set)
+	diags := reconcileMembershipChange(d, roleGroupMembership(ctx, c, role.ID))
+	diags = append(diags, reconcileMembershipChange(d, rolePermissionMembership(ctx, c, role.ID))...)
 
-		removals := oTypes.Difference(nTypes)
-		for _, removal := range removals.List() {
-			if err := c.RemoveRolePermission(role.ID, removal.(string)); err != nil {
-				return err
-			}
-		}
-
-		additions := nTypes.Difference(oTypes)
-		for _, addition := range additions.List() {
-			if err := c.AddRolePermission(role.ID, addition.(string)); err != nil {
-				return err
-			}
-		}
-	}
-
-	return resourceRoleRead(d, m)
+	return append(diags, resourceRoleRead(ctx, d, m)...)
 }
 
-func resourceRoleDelete(d *schema.ResourceData, m interface{}) error {
+func resourceRoleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	id, _ := strconv.Atoi(d.Id())
-	err := c.DeleteRole(id)
+	err := c.DeleteRole(ctx, id)
 	if err == nil {
 		d.SetId("")
 	}
 
-	return err
+	return diag.FromErr(err)
 }
 
-func resourceRoleImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceRoleImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	c := m.(client.MayanEdmsClient)
 	id, err := strconv.Atoi(d.Id())
 	rd := []*schema.ResourceData{d}
@@ -170,12 +141,12 @@ func resourceRoleImport(d *schema.ResourceData, m interface{}) ([]*schema.Resour
 		return rd, err
 	}
 
-	role, err := c.GetRoleById(id)
+	role, err := c.GetRoleById(ctx, id)
 	if err != nil {
 		return rd, err
 	}
 
-	groups, err := c.GetRoleGroups(role.ID)
+	groups, err := c.GetRoleGroups(ctx, role.ID)
 	if err != nil {
 		return rd, err
 	}
@@ -184,7 +155,7 @@ func resourceRoleImport(d *schema.ResourceData, m interface{}) ([]*schema.Resour
 		return rd, err
 	}
 
-	permissions, err := c.GetRolePermissions(role.ID)
+	permissions, err := c.GetRolePermissions(ctx, role.ID)
 	if err != nil {
 		return rd, err
 	}
@@ -197,6 +168,34 @@ func resourceRoleImport(d *schema.ResourceData, m interface{}) ([]*schema.Resour
 	return rd, err
 }
 
+func roleGroupMembership(ctx context.Context, c client.MayanEdmsClient, roleId int) membership {
+	return membership{
+		attribute: "groups",
+		member:    "group",
+		owner:     "role",
+		add: func(group interface{}) error {
+			return c.AddRoleGroup(ctx, roleId, group.(int))
+		},
+		remove: func(group interface{}) error {
+			return c.RemoveRoleGroup(ctx, roleId, group.(int))
+		},
+	}
+}
+
+func rolePermissionMembership(ctx context.Context, c client.MayanEdmsClient, roleId int) membership {
+	return membership{
+		attribute: "permissions",
+		member:    "permission",
+		owner:     "role",
+		add: func(permission interface{}) error {
+			return c.AddRolePermission(ctx, roleId, permission.(string))
+		},
+		remove: func(permission interface{}) error {
+			return c.RemoveRolePermission(ctx, roleId, permission.(string))
+		},
+	}
+}
+
 func roleToData(role *client.Role, d *schema.ResourceData) error {
 	d.SetId(fmt.Sprintf("%v", role.ID))
 	if err := d.Set("label", role.Label); err != nil {
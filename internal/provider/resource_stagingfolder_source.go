@@ -1,12 +1,16 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/rfleming71/terraform-provider-mayan-edms/client"
@@ -22,12 +26,17 @@ type stagingFolderSourceBackendDataType struct {
 
 func resourceStagingFolderSource() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceStagingFolderSourceCreate,
-		Read:   resourceStagingFolderSourceRead,
-		Update: resourceStagingFolderSourceUpdate,
-		Delete: resourceStagingFolderSourceDelete,
+		CreateContext: resourceStagingFolderSourceCreate,
+		ReadContext:   resourceStagingFolderSourceRead,
+		UpdateContext: resourceStagingFolderSourceUpdate,
+		DeleteContext: resourceStagingFolderSourceDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceStagingFolderSourceImport,
+			StateContext: resourceStagingFolderSourceImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -71,57 +80,64 @@ func resourceStagingFolderSource() *schema.Resource {
 	}
 }
 
-func resourceStagingFolderSourceCreate(d *schema.ResourceData, m interface{}) error {
+func resourceStagingFolderSourceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	newSource := dataToStagingFolderSource(d)
 
-	source, err := c.CreateSource(*newSource)
+	source, err := c.CreateSource(ctx, *newSource)
 
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(d, "Unable to create staging folder source", err)
 	}
 
 	d.SetId(fmt.Sprintf("%v", source.ID))
 
-	return resourceStagingFolderSourceRead(d, m)
+	return resourceStagingFolderSourceRead(ctx, d, m)
 }
 
-func resourceStagingFolderSourceRead(d *schema.ResourceData, m interface{}) error {
+func resourceStagingFolderSourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	id, _ := strconv.Atoi(d.Id())
 
-	source, err := c.GetSourceById(id)
+	source, err := c.GetSourceById(ctx, id)
 	if err != nil {
-		return err
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Staging folder source not found, removing from state", map[string]interface{}{
+				"id": d.Id(),
+			})
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
 	}
 
-	return stagingFolderSourceToData(source, d)
+	return diag.FromErr(stagingFolderSourceToData(source, d))
 }
 
-func resourceStagingFolderSourceUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceStagingFolderSourceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	source := dataToStagingFolderSource(d)
 	source.ID, _ = strconv.Atoi(d.Id())
-	_, err := c.UpdateSource(*source)
+	_, err := c.UpdateSource(ctx, *source)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(d, "Unable to update staging folder source", err)
 	}
 
-	return resourceStagingFolderSourceRead(d, m)
+	return resourceStagingFolderSourceRead(ctx, d, m)
 }
 
-func resourceStagingFolderSourceDelete(d *schema.ResourceData, m interface{}) error {
+func resourceStagingFolderSourceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	id, _ := strconv.Atoi(d.Id())
-	err := c.DeleteSource(id)
+	err := c.DeleteSource(ctx, id)
 	if err == nil {
 		d.SetId("")
 	}
 
-	return err
+	return diag.FromErr(err)
 }
 
-func resourceStagingFolderSourceImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceStagingFolderSourceImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	c := m.(client.MayanEdmsClient)
 	id, err := strconv.Atoi(d.Id())
 	rd := []*schema.ResourceData{d}
@@ -129,7 +145,7 @@ func resourceStagingFolderSourceImport(d *schema.ResourceData, m interface{}) ([
 		return rd, err
 	}
 
-	source, err := c.GetSourceById(id)
+	source, err := c.GetSourceById(ctx, id)
 	if err != nil {
 		return rd, err
 	}
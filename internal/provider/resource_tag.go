@@ -1,21 +1,30 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/rfleming71/terraform-provider-mayan-edms/client"
 )
 
 func resourceTag() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTagCreate,
-		Read:   resourceTagRead,
-		Update: resourceTagUpdate,
-		Delete: resourceTagDelete,
+		CreateContext: resourceTagCreate,
+		ReadContext:   resourceTagRead,
+		UpdateContext: resourceTagUpdate,
+		DeleteContext: resourceTagDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceTagImport,
+			StateContext: resourceTagImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -33,57 +42,64 @@ func resourceTag() *schema.Resource {
 	}
 }
 
-func resourceTagCreate(d *schema.ResourceData, m interface{}) error {
+func resourceTagCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	newTag := dataToTag(d)
 
-	tag, err := c.CreateTag(*newTag)
+	tag, err := c.CreateTag(ctx, *newTag)
 
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(d, "Unable to create tag", err)
 	}
 
 	d.SetId(fmt.Sprintf("%v", tag.ID))
 
-	return resourceTagRead(d, m)
+	return resourceTagRead(ctx, d, m)
 }
 
-func resourceTagRead(d *schema.ResourceData, m interface{}) error {
+func resourceTagRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	id, _ := strconv.Atoi(d.Id())
 
-	source, err := c.GetTagById(id)
+	source, err := c.GetTagById(ctx, id)
 	if err != nil {
-		return err
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Tag not found, removing from state", map[string]interface{}{
+				"id": d.Id(),
+			})
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
 	}
 
-	return tagToData(source, d)
+	return diag.FromErr(tagToData(source, d))
 }
 
-func resourceTagUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceTagUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	tag := dataToTag(d)
 	tag.ID, _ = strconv.Atoi(d.Id())
-	_, err := c.UpdateTag(*tag)
+	_, err := c.UpdateTag(ctx, *tag)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(d, "Unable to update tag", err)
 	}
 
-	return resourceTagRead(d, m)
+	return resourceTagRead(ctx, d, m)
 }
 
-func resourceTagDelete(d *schema.ResourceData, m interface{}) error {
+func resourceTagDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	id, _ := strconv.Atoi(d.Id())
-	err := c.DeleteTag(id)
+	err := c.DeleteTag(ctx, id)
 	if err == nil {
 		d.SetId("")
 	}
 
-	return err
+	return diag.FromErr(err)
 }
 
-func resourceTagImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceTagImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	c := m.(client.MayanEdmsClient)
 	id, err := strconv.Atoi(d.Id())
 	rd := []*schema.ResourceData{d}
@@ -91,7 +107,7 @@ func resourceTagImport(d *schema.ResourceData, m interface{}) ([]*schema.Resourc
 		return rd, err
 	}
 
-	tag, err := c.GetTagById(id)
+	tag, err := c.GetTagById(ctx, id)
 	if err != nil {
 		return rd, err
 	}
@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rfleming71/terraform-provider-mayan-edms/client"
+)
+
+func resourceUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUserCreate,
+		ReadContext:   resourceUserRead,
+		UpdateContext: resourceUserUpdate,
+		DeleteContext: resourceUserDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUserImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"username": {
+				Description: "Name used to log in. Letters, digits and @/./+/-/_ only.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"first_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			"last_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			"active": {
+				Description: "Whether the user account can log in.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"password": {
+				Description: "Password of the user. It is only sent to Mayan when it changes and is never read back, so changes made outside of Terraform are not detected.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	newUser := dataToUser(d)
+	newUser.Password = d.Get("password").(string)
+
+	user, err := c.CreateUser(ctx, *newUser)
+
+	if err != nil {
+		return apiErrorDiagnostics(d, "Unable to create user", err)
+	}
+
+	d.SetId(fmt.Sprintf("%v", user.ID))
+
+	return resourceUserRead(ctx, d, m)
+}
+
+func resourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	id, _ := strconv.Atoi(d.Id())
+
+	user, err := c.GetUserById(ctx, id)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "User not found, removing from state", map[string]interface{}{
+				"id": d.Id(),
+			})
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(userToData(user, d))
+}
+
+func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	user := dataToUser(d)
+	user.ID, _ = strconv.Atoi(d.Id())
+	if d.HasChange("password") {
+		user.Password = d.Get("password").(string)
+	}
+
+	_, err := c.UpdateUser(ctx, *user)
+	if err != nil {
+		return apiErrorDiagnostics(d, "Unable to update user", err)
+	}
+
+	return resourceUserRead(ctx, d, m)
+}
+
+func resourceUserDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	id, _ := strconv.Atoi(d.Id())
+	err := c.DeleteUser(ctx, id)
+	if err == nil {
+		d.SetId("")
+	}
+
+	return diag.FromErr(err)
+}
+
+func resourceUserImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(client.MayanEdmsClient)
+	id, err := strconv.Atoi(d.Id())
+	rd := []*schema.ResourceData{d}
+	if err != nil {
+		return rd, err
+	}
+
+	user, err := c.GetUserById(ctx, id)
+	if err != nil {
+		return rd, err
+	}
+
+	err = userToData(user, d)
+	return rd, err
+}
+
+func userToData(user *client.User, d *schema.ResourceData) error {
+	d.SetId(fmt.Sprintf("%v", user.ID))
+	if err := d.Set("username", user.Username); err != nil {
+		return err
+	}
+	if err := d.Set("first_name", user.FirstName); err != nil {
+		return err
+	}
+	if err := d.Set("last_name", user.LastName); err != nil {
+		return err
+	}
+	if err := d.Set("email", user.Email); err != nil {
+		return err
+	}
+	if err := d.Set("active", user.IsActive); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func dataToUser(d *schema.ResourceData) *client.User {
+	id, _ := strconv.Atoi(d.Id())
+	newUser := client.User{
+		ID:        id,
+		Username:  d.Get("username").(string),
+		FirstName: d.Get("first_name").(string),
+		LastName:  d.Get("last_name").(string),
+		Email:     d.Get("email").(string),
+		IsActive:  d.Get("active").(bool),
+	}
+
+	return &newUser
+}
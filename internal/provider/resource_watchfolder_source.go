@@ -1,12 +1,16 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/rfleming71/terraform-provider-mayan-edms/client"
@@ -22,12 +26,17 @@ type watchFolderSourceBackendDataType struct {
 
 func resourceWatchFolderSource() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceWatchFolderSourceCreate,
-		Read:   resourceWatchFolderSourceRead,
-		Update: resourceWatchFolderSourceUpdate,
-		Delete: resourceWatchFolderSourceDelete,
+		CreateContext: resourceWatchFolderSourceCreate,
+		ReadContext:   resourceWatchFolderSourceRead,
+		UpdateContext: resourceWatchFolderSourceUpdate,
+		DeleteContext: resourceWatchFolderSourceDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceWatchFolderSourceImport,
+			StateContext: resourceWatchFolderSourceImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -69,57 +78,64 @@ func resourceWatchFolderSource() *schema.Resource {
 	}
 }
 
-func resourceWatchFolderSourceCreate(d *schema.ResourceData, m interface{}) error {
+func resourceWatchFolderSourceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	newSource := dataToWatchFolderSource(d)
 
-	source, err := c.CreateSource(*newSource)
+	source, err := c.CreateSource(ctx, *newSource)
 
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(d, "Unable to create watch folder source", err)
 	}
 
 	d.SetId(fmt.Sprintf("%v", source.ID))
 
-	return resourceWatchFolderSourceRead(d, m)
+	return resourceWatchFolderSourceRead(ctx, d, m)
 }
 
-func resourceWatchFolderSourceRead(d *schema.ResourceData, m interface{}) error {
+func resourceWatchFolderSourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	id, _ := strconv.Atoi(d.Id())
 
-	source, err := c.GetSourceById(id)
+	source, err := c.GetSourceById(ctx, id)
 	if err != nil {
-		return err
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Watch folder source not found, removing from state", map[string]interface{}{
+				"id": d.Id(),
+			})
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
 	}
 
-	return watchFolderSourceToData(source, d)
+	return diag.FromErr(watchFolderSourceToData(source, d))
 }
 
-func resourceWatchFolderSourceUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceWatchFolderSourceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	source := dataToWatchFolderSource(d)
 	source.ID, _ = strconv.Atoi(d.Id())
-	_, err := c.UpdateSource(*source)
+	_, err := c.UpdateSource(ctx, *source)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(d, "Unable to update watch folder source", err)
 	}
 
-	return resourceWatchFolderSourceRead(d, m)
+	return resourceWatchFolderSourceRead(ctx, d, m)
 }
 
-func resourceWatchFolderSourceDelete(d *schema.ResourceData, m interface{}) error {
+func resourceWatchFolderSourceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	id, _ := strconv.Atoi(d.Id())
-	err := c.DeleteSource(id)
+	err := c.DeleteSource(ctx, id)
 	if err == nil {
 		d.SetId("")
 	}
 
-	return err
+	return diag.FromErr(err)
 }
 
-func resourceWatchFolderSourceImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceWatchFolderSourceImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	c := m.(client.MayanEdmsClient)
 	id, err := strconv.Atoi(d.Id())
 	rd := []*schema.ResourceData{d}
@@ -127,7 +143,7 @@ func resourceWatchFolderSourceImport(d *schema.ResourceData, m interface{}) ([]*
 		return rd, err
 	}
 
-	source, err := c.GetSourceById(id)
+	source, err := c.GetSourceById(ctx, id)
 	if err != nil {
 		return rd, err
 	}
@@ -1,12 +1,16 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/rfleming71/terraform-provider-mayan-edms/client"
@@ -24,12 +28,17 @@ var uncompressedMapping = map[string]string{
 
 func resourceWebformSource() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceWebformSourceCreate,
-		Read:   resourceWebformSourceRead,
-		Update: resourceWebformSourceUpdate,
-		Delete: resourceWebformSourceDelete,
+		CreateContext: resourceWebformSourceCreate,
+		ReadContext:   resourceWebformSourceRead,
+		UpdateContext: resourceWebformSourceUpdate,
+		DeleteContext: resourceWebformSourceDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceWebformSourceImport,
+			StateContext: resourceWebformSourceImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -53,57 +62,64 @@ func resourceWebformSource() *schema.Resource {
 	}
 }
 
-func resourceWebformSourceCreate(d *schema.ResourceData, m interface{}) error {
+func resourceWebformSourceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	newSource := dataToWebformSource(d)
 
-	source, err := c.CreateSource(*newSource)
+	source, err := c.CreateSource(ctx, *newSource)
 
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(d, "Unable to create webform source", err)
 	}
 
 	d.SetId(fmt.Sprintf("%v", source.ID))
 
-	return resourceWebformSourceRead(d, m)
+	return resourceWebformSourceRead(ctx, d, m)
 }
 
-func resourceWebformSourceRead(d *schema.ResourceData, m interface{}) error {
+func resourceWebformSourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	id, _ := strconv.Atoi(d.Id())
 
-	source, err := c.GetSourceById(id)
+	source, err := c.GetSourceById(ctx, id)
 	if err != nil {
-		return err
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Webform source not found, removing from state", map[string]interface{}{
+				"id": d.Id(),
+			})
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
 	}
 
-	return webformSourceToData(source, d)
+	return diag.FromErr(webformSourceToData(source, d))
 }
 
-func resourceWebformSourceUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceWebformSourceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	source := dataToWebformSource(d)
 	source.ID, _ = strconv.Atoi(d.Id())
-	_, err := c.UpdateSource(*source)
+	_, err := c.UpdateSource(ctx, *source)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(d, "Unable to update webform source", err)
 	}
 
-	return resourceWebformSourceRead(d, m)
+	return resourceWebformSourceRead(ctx, d, m)
 }
 
-func resourceWebformSourceDelete(d *schema.ResourceData, m interface{}) error {
+func resourceWebformSourceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	id, _ := strconv.Atoi(d.Id())
-	err := c.DeleteSource(id)
+	err := c.DeleteSource(ctx, id)
 	if err == nil {
 		d.SetId("")
 	}
 
-	return err
+	return diag.FromErr(err)
 }
 
-func resourceWebformSourceImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceWebformSourceImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	c := m.(client.MayanEdmsClient)
 	id, err := strconv.Atoi(d.Id())
 	rd := []*schema.ResourceData{d}
@@ -111,7 +127,7 @@ func resourceWebformSourceImport(d *schema.ResourceData, m interface{}) ([]*sche
 		return rd, err
 	}
 
-	source, err := c.GetSourceById(id)
+	source, err := c.GetSourceById(ctx, id)
 	if err != nil {
 		return rd, err
 	}
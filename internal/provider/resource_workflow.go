@@ -0,0 +1,532 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rfleming71/terraform-provider-mayan-edms/client"
+)
+
+// workflowTransition is a transition of the composite workflow resource, with
+// its origin and destination states referred to by label.
+type workflowTransition struct {
+	label            string
+	originState      string
+	destinationState string
+	condition        string
+}
+
+func resourceWorkflow() *schema.Resource {
+	s := resourceWorkflowTemplate().Schema
+	s["state"] = &schema.Schema{
+		Description: "States of the workflow. States are matched to the existing ones by label, so changing a label replaces the state.",
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"label": {
+					Description: "Short text to describe the workflow state. Must be unique within the workflow.",
+					Type:        schema.TypeString,
+					Required:    true,
+				},
+				"completion": {
+					Description:  "The percent of completion that this state represents in relation to the workflow.",
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+				"initial": {
+					Description: "The state at which the workflow will start in. Only one state can be initial.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+				},
+			},
+		},
+	}
+	s["transition"] = &schema.Schema{
+		Description: "Transitions between the states of the workflow. Transitions are matched to the existing ones by label.",
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"label": {
+					Description: "Short text to describe the transition. Must be unique within the workflow.",
+					Type:        schema.TypeString,
+					Required:    true,
+				},
+				"origin_state": {
+					Description: "Label of the state the transition leaves.",
+					Type:        schema.TypeString,
+					Required:    true,
+				},
+				"destination_state": {
+					Description: "Label of the state the transition enters.",
+					Type:        schema.TypeString,
+					Required:    true,
+				},
+				"condition": {
+					Description:      "The condition that will determine if this transition is enabled or not.",
+					Type:             schema.TypeString,
+					Optional:         true,
+					Default:          "",
+					ValidateDiagFunc: validateDjangoTemplateString,
+				},
+			},
+		},
+	}
+	s["state_ids"] = &schema.Schema{
+		Description: "Ids of the states keyed by label, in the form used by `mayanedms_workflow_template_state_action` and `mayanedms_workflow_template_state_escalation`.",
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+	s["transition_ids"] = &schema.Schema{
+		Description: "Ids of the transitions keyed by label, in the form used by `mayanedms_workflow_template_transition_trigger` and `mayanedms_workflow_template_transition_field`.",
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+
+	return &schema.Resource{
+		Description: "A workflow template declared with its states and transitions. Transitions between undeclared states and more than one initial state fail the plan. " +
+			"States that cannot be reached from the initial state and states that cannot be left before the workflow completes are only logged as warnings during the plan, " +
+			"visible with `TF_LOG=WARN`, and are reported as warning diagnostics when the workflow is applied.",
+		CreateContext: resourceWorkflowCreate,
+		ReadContext:   resourceWorkflowRead,
+		UpdateContext: resourceWorkflowUpdate,
+		DeleteContext: resourceWorkflowDelete,
+		CustomizeDiff: resourceWorkflowCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceWorkflowImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: s,
+	}
+}
+
+func resourceWorkflowCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	newWorkflowTemplate := dataToWorkflowTemplate(d)
+
+	workflowTemplate, err := c.CreateWorkflowTemplate(ctx, *newWorkflowTemplate)
+
+	if err != nil {
+		return apiErrorDiagnostics(d, "Unable to create workflow", err)
+	}
+
+	d.SetId(fmt.Sprintf("%v", workflowTemplate.ID))
+
+	diags := reconcileNewMembership(d, workflowTemplateDocumentTypeMembership(ctx, c, workflowTemplate.ID))
+	diags = append(diags, reconcileWorkflowGraph(ctx, c, workflowTemplate.ID, d)...)
+	diags = append(diags, workflowGraphDiagnostics(d)...)
+
+	return append(diags, resourceWorkflowRead(ctx, d, m)...)
+}
+
+func resourceWorkflowRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	id, _ := strconv.Atoi(d.Id())
+
+	err := workflowToData(ctx, c, id, d)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Workflow not found, removing from state", map[string]interface{}{
+				"id": d.Id(),
+			})
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceWorkflowUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	workflowTemplate := dataToWorkflowTemplate(d)
+	workflowTemplate.ID, _ = strconv.Atoi(d.Id())
+	_, err := c.UpdateWorkflowTemplate(ctx, *workflowTemplate)
+	if err != nil {
+		return apiErrorDiagnostics(d, "Unable to update workflow", err)
+	}
+	diags := reconcileMembershipChange(d, workflowTemplateDocumentTypeMembership(ctx, c, workflowTemplate.ID))
+
+	if d.HasChanges("state", "transition") {
+		diags = append(diags, reconcileWorkflowGraph(ctx, c, workflowTemplate.ID, d)...)
+		diags = append(diags, workflowGraphDiagnostics(d)...)
+	}
+
+	return append(diags, resourceWorkflowRead(ctx, d, m)...)
+}
+
+func resourceWorkflowDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	id, _ := strconv.Atoi(d.Id())
+	err := c.DeleteWorkflowTemplate(ctx, id)
+	if err == nil {
+		d.SetId("")
+	}
+
+	return diag.FromErr(err)
+}
+
+func resourceWorkflowImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(client.MayanEdmsClient)
+	id, err := strconv.Atoi(d.Id())
+	rd := []*schema.ResourceData{d}
+	if err != nil {
+		return rd, err
+	}
+
+	err = workflowToData(ctx, c, id, d)
+	return rd, err
+}
+
+// resourceWorkflowCustomizeDiff validates the graph of states and transitions
+// at plan time. Unreachable and dead-end states are not errors; they are
+// logged here and reported as warnings when the workflow is applied.
+func resourceWorkflowCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	states := dataToWorkflowStates(d.Get("state").([]interface{}))
+	transitions := dataToWorkflowTransitions(d.Get("transition").([]interface{}))
+	if !workflowGraphKnown(states, transitions) {
+		return nil
+	}
+
+	if err := validateWorkflowGraph(states, transitions); err != nil {
+		return err
+	}
+
+	for _, warning := range workflowGraphWarnings(states, transitions) {
+		tflog.Warn(ctx, "Workflow graph is incomplete", map[string]interface{}{
+			"label":   d.Get("label"),
+			"warning": warning,
+		})
+	}
+
+	return nil
+}
+
+// workflowGraphKnown reports whether every label of the graph is known, which
+// isn't the case at plan time when a label is computed from another resource.
+func workflowGraphKnown(states []client.WorkflowTemplateState, transitions []workflowTransition) bool {
+	for _, state := range states {
+		if state.Label == "" {
+			return false
+		}
+	}
+
+	for _, transition := range transitions {
+		if transition.label == "" || transition.originState == "" || transition.destinationState == "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+// workflowGraphDiagnostics reports the warnings of the configured graph.
+func workflowGraphDiagnostics(d *schema.ResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+	states := dataToWorkflowStates(d.Get("state").([]interface{}))
+	transitions := dataToWorkflowTransitions(d.Get("transition").([]interface{}))
+	for _, warning := range workflowGraphWarnings(states, transitions) {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       "Workflow graph is incomplete",
+			Detail:        strings.ToUpper(warning[:1]) + warning[1:] + ".",
+			AttributePath: cty.GetAttrPath("state"),
+		})
+	}
+
+	return diags
+}
+
+// reconcileWorkflowGraph brings the states and transitions of a workflow
+// template in line with the configuration, touching only what differs.
+// Transitions that go away are removed first and states last, so that no
+// transition still declared is deleted along with one of its states.
+func reconcileWorkflowGraph(ctx context.Context, c client.MayanEdmsClient, workflowTemplateId int, d *schema.ResourceData) diag.Diagnostics {
+	currentStates, err := c.GetWorkflowTemplateStates(ctx, workflowTemplateId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	currentTransitions, err := c.GetWorkflowTemplateTransitions(ctx, workflowTemplateId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	desiredStates := dataToWorkflowStates(d.Get("state").([]interface{}))
+	desiredTransitions := dataToWorkflowTransitions(d.Get("transition").([]interface{}))
+
+	var failures []string
+	fail := func(format string, args ...interface{}) {
+		failures = append(failures, fmt.Sprintf(format, args...))
+	}
+
+	desiredTransitionLabels := map[string]bool{}
+	for _, transition := range desiredTransitions {
+		desiredTransitionLabels[transition.label] = true
+	}
+
+	existingTransitions := map[string]client.WorkflowTemplateTransition{}
+	for _, transition := range currentTransitions {
+		_, duplicate := existingTransitions[transition.Label]
+		if desiredTransitionLabels[transition.Label] && !duplicate {
+			existingTransitions[transition.Label] = transition
+			continue
+		}
+
+		if err := c.RemoveWorkflowTemplateTransition(ctx, workflowTemplateId, transition.ID); err != nil {
+			fail("remove transition %q: %v", transition.Label, err)
+		}
+	}
+
+	existingStates := map[string]client.WorkflowTemplateState{}
+	for _, state := range currentStates {
+		if _, duplicate := existingStates[state.Label]; !duplicate {
+			existingStates[state.Label] = state
+		}
+	}
+
+	stateIds := map[string]int{}
+	for _, state := range desiredStates {
+		existing, ok := existingStates[state.Label]
+		if !ok {
+			created, err := c.CreateWorkflowTemplateState(ctx, workflowTemplateId, state)
+			if err != nil {
+				fail("create state %q: %v", state.Label, err)
+				continue
+			}
+			stateIds[state.Label] = created.ID
+			continue
+		}
+
+		stateIds[state.Label] = existing.ID
+		if existing.Completion == state.Completion && existing.Initial == state.Initial {
+			continue
+		}
+
+		state.ID = existing.ID
+		if _, err := c.UpdateWorkflowTemplateState(ctx, workflowTemplateId, state); err != nil {
+			fail("update state %q: %v", state.Label, err)
+		}
+	}
+
+	for _, transition := range desiredTransitions {
+		originId, originOk := stateIds[transition.originState]
+		destinationId, destinationOk := stateIds[transition.destinationState]
+		if !originOk || !destinationOk {
+			fail("transition %q skipped, its states are missing", transition.label)
+			continue
+		}
+
+		desired := client.WorkflowTemplateTransition{
+			Label:            transition.label,
+			Condition:        transition.condition,
+			OriginState:      client.WorkflowTemplateState{ID: originId},
+			DestinationState: client.WorkflowTemplateState{ID: destinationId},
+		}
+
+		existing, ok := existingTransitions[transition.label]
+		if !ok {
+			if _, err := c.CreateWorkflowTemplateTransition(ctx, workflowTemplateId, desired); err != nil {
+				fail("create transition %q: %v", transition.label, err)
+			}
+			continue
+		}
+
+		if existing.Condition == desired.Condition && existing.OriginState.ID == originId && existing.DestinationState.ID == destinationId {
+			continue
+		}
+
+		desired.ID = existing.ID
+		if _, err := c.UpdateWorkflowTemplateTransition(ctx, workflowTemplateId, desired); err != nil {
+			fail("update transition %q: %v", transition.label, err)
+		}
+	}
+
+	for _, state := range currentStates {
+		if stateIds[state.Label] == state.ID {
+			continue
+		}
+
+		if err := c.RemoveWorkflowTemplateState(ctx, workflowTemplateId, state.ID); err != nil {
+			fail("remove state %q: %v", state.Label, err)
+		}
+	}
+
+	if len(failures) == 0 {
+		return nil
+	}
+
+	return diag.Diagnostics{{
+		Severity:      diag.Error,
+		Summary:       "Unable to update states and transitions of workflow",
+		Detail:        fmt.Sprintf("%v changes failed:\n\n%v", len(failures), strings.Join(failures, "\n")),
+		AttributePath: cty.GetAttrPath("state"),
+	}}
+}
+
+func workflowToData(ctx context.Context, c client.MayanEdmsClient, id int, d *schema.ResourceData) error {
+	workflowTemplate, err := c.GetWorkflowTemplateById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	docTypes, err := c.GetWorkflowIndexDocumentTypes(ctx, workflowTemplate.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("document_types", docTypes); err != nil {
+		return err
+	}
+
+	states, err := c.GetWorkflowTemplateStates(ctx, workflowTemplate.ID)
+	if err != nil {
+		return err
+	}
+
+	transitions, err := c.GetWorkflowTemplateTransitions(ctx, workflowTemplate.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := workflowGraphToData(workflowTemplate.ID, states, transitions, d); err != nil {
+		return err
+	}
+
+	return workflowTemplateToData(workflowTemplate, d)
+}
+
+// workflowGraphToData sets the states and transitions read from the server,
+// keeping the order of the configuration so that only real changes show up
+// in a plan. Anything not configured is appended in the order of its id.
+func workflowGraphToData(workflowTemplateId int, states []client.WorkflowTemplateState, transitions []client.WorkflowTemplateTransition, d *schema.ResourceData) error {
+	stateOrder := map[string]int{}
+	for i, s := range d.Get("state").([]interface{}) {
+		stateOrder[s.(map[string]interface{})["label"].(string)] = i
+	}
+
+	transitionOrder := map[string]int{}
+	for i, t := range d.Get("transition").([]interface{}) {
+		transitionOrder[t.(map[string]interface{})["label"].(string)] = i
+	}
+
+	sort.SliceStable(states, func(i, j int) bool {
+		return configuredBefore(stateOrder, states[i].Label, states[i].ID, states[j].Label, states[j].ID)
+	})
+
+	sort.SliceStable(transitions, func(i, j int) bool {
+		return configuredBefore(transitionOrder, transitions[i].Label, transitions[i].ID, transitions[j].Label, transitions[j].ID)
+	})
+
+	stateLabels := map[int]string{}
+	stateIds := map[string]interface{}{}
+	stateList := make([]interface{}, 0, len(states))
+	for _, state := range states {
+		stateLabels[state.ID] = state.Label
+		stateIds[state.Label] = fmt.Sprintf("%v-%v", workflowTemplateId, state.ID)
+		stateList = append(stateList, map[string]interface{}{
+			"label":      state.Label,
+			"completion": state.Completion,
+			"initial":    state.Initial,
+		})
+	}
+
+	transitionIds := map[string]interface{}{}
+	transitionList := make([]interface{}, 0, len(transitions))
+	for _, transition := range transitions {
+		transitionIds[transition.Label] = fmt.Sprintf("%v-%v", workflowTemplateId, transition.ID)
+		transitionList = append(transitionList, map[string]interface{}{
+			"label":             transition.Label,
+			"origin_state":      stateLabels[transition.OriginState.ID],
+			"destination_state": stateLabels[transition.DestinationState.ID],
+			"condition":         transition.Condition,
+		})
+	}
+
+	if err := d.Set("state", stateList); err != nil {
+		return err
+	}
+
+	if err := d.Set("transition", transitionList); err != nil {
+		return err
+	}
+
+	if err := d.Set("state_ids", stateIds); err != nil {
+		return err
+	}
+
+	if err := d.Set("transition_ids", transitionIds); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// configuredBefore orders items by their position in the configuration, then
+// unconfigured items by id.
+func configuredBefore(order map[string]int, labelA string, idA int, labelB string, idB int) bool {
+	positionA, configuredA := order[labelA]
+	positionB, configuredB := order[labelB]
+	switch {
+	case configuredA && configuredB:
+		return positionA < positionB
+	case configuredA != configuredB:
+		return configuredA
+	default:
+		return idA < idB
+	}
+}
+
+func dataToWorkflowStates(states []interface{}) []client.WorkflowTemplateState {
+	result := make([]client.WorkflowTemplateState, 0, len(states))
+	for _, s := range states {
+		state := s.(map[string]interface{})
+		result = append(result, client.WorkflowTemplateState{
+			Label:      state["label"].(string),
+			Completion: state["completion"].(int),
+			Initial:    state["initial"].(bool),
+		})
+	}
+
+	return result
+}
+
+func dataToWorkflowTransitions(transitions []interface{}) []workflowTransition {
+	result := make([]workflowTransition, 0, len(transitions))
+	for _, t := range transitions {
+		transition := t.(map[string]interface{})
+		result = append(result, workflowTransition{
+			label:            transition["label"].(string),
+			originState:      transition["origin_state"].(string),
+			destinationState: transition["destination_state"].(string),
+			condition:        transition["condition"].(string),
+		})
+	}
+
+	return result
+}
@@ -1,21 +1,30 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/rfleming71/terraform-provider-mayan-edms/client"
 )
 
 func resourceWorkflowTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceWorkflowTemplateCreate,
-		Read:   resourceWorkflowTemplateRead,
-		Update: resourceWorkflowTemplateUpdate,
-		Delete: resourceWorkflowTemplateDelete,
+		CreateContext: resourceWorkflowTemplateCreate,
+		ReadContext:   resourceWorkflowTemplateRead,
+		UpdateContext: resourceWorkflowTemplateUpdate,
+		DeleteContext: resourceWorkflowTemplateDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceWorkflowTemplateImport,
+			StateContext: resourceWorkflowTemplateImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -40,97 +49,76 @@ func resourceWorkflowTemplate() *schema.Resource {
 	}
 }
 
-func resourceWorkflowTemplateCreate(d *schema.ResourceData, m interface{}) error {
+func resourceWorkflowTemplateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	newWorkflowTemplate := dataToWorkflowTemplate(d)
 
-	workflowTemplate, err := c.CreateWorkflowTemplate(*newWorkflowTemplate)
+	workflowTemplate, err := c.CreateWorkflowTemplate(ctx, *newWorkflowTemplate)
 
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(d, "Unable to create workflow template", err)
 	}
 
 	d.SetId(fmt.Sprintf("%v", workflowTemplate.ID))
 
-	dTypes := d.Get("document_types").(*schema.Set).List()
-	documentTypes := []int{}
-	for _, tag := range dTypes {
-		t := tag.(int)
-		documentTypes = append(documentTypes, t)
-	}
-
-	for _, docType := range documentTypes {
-		_ = c.AddWorkflowIndexDocumentType(workflowTemplate.ID, docType)
-	}
+	diags := reconcileNewMembership(d, workflowTemplateDocumentTypeMembership(ctx, c, workflowTemplate.ID))
 
-	return resourceWorkflowTemplateRead(d, m)
+	return append(diags, resourceWorkflowTemplateRead(ctx, d, m)...)
 }
 
-func resourceWorkflowTemplateRead(d *schema.ResourceData, m interface{}) error {
+func resourceWorkflowTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	id, _ := strconv.Atoi(d.Id())
 
-	source, err := c.GetWorkflowTemplateById(id)
+	source, err := c.GetWorkflowTemplateById(ctx, id)
 	if err != nil {
-		return err
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Workflow template not found, removing from state", map[string]interface{}{
+				"id": d.Id(),
+			})
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
 	}
 
-	docTypes, err := c.GetWorkflowIndexDocumentTypes(source.ID)
+	docTypes, err := c.GetWorkflowIndexDocumentTypes(ctx, source.ID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("document_types", docTypes); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return workflowTemplateToData(source, d)
+	return diag.FromErr(workflowTemplateToData(source, d))
 }
 
-func resourceWorkflowTemplateUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceWorkflowTemplateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	workflowTemplate := dataToWorkflowTemplate(d)
 	workflowTemplate.ID, _ = strconv.Atoi(d.Id())
-	_, err := c.UpdateWorkflowTemplate(*workflowTemplate)
+	_, err := c.UpdateWorkflowTemplate(ctx, *workflowTemplate)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(d, "Unable to update workflow template", err)
 	}
-	if d.HasChange("document_types") {
-		o, n := d.GetChange("document_types")
-
-		oTypes := o.(*schema.Set)
-		nTypes := n.(*schema.Set)
+	diags := reconcileMembershipChange(d, workflowTemplateDocumentTypeMembership(ctx, c, workflowTemplate.ID))
 
-		removals := oTypes.Difference(nTypes)
-		for _, removal := range removals.List() {
-			if err := c.RemoveWorkflowIndexDocumentType(workflowTemplate.ID, removal.(int)); err != nil {
-				return err
-			}
-		}
-
-		additions := nTypes.Difference(oTypes)
-		for _, addition := range additions.List() {
-			if err := c.AddWorkflowIndexDocumentType(workflowTemplate.ID, addition.(int)); err != nil {
-				return err
-			}
-		}
-	}
-
-	return resourceWorkflowTemplateRead(d, m)
+	return append(diags, resourceWorkflowTemplateRead(ctx, d, m)...)
 }
 
-func resourceWorkflowTemplateDelete(d *schema.ResourceData, m interface{}) error {
+func resourceWorkflowTemplateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	id, _ := strconv.Atoi(d.Id())
-	err := c.DeleteWorkflowTemplate(id)
+	err := c.DeleteWorkflowTemplate(ctx, id)
 	if err == nil {
 		d.SetId("")
 	}
 
-	return err
+	return diag.FromErr(err)
 }
 
-func resourceWorkflowTemplateImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceWorkflowTemplateImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	c := m.(client.MayanEdmsClient)
 	id, err := strconv.Atoi(d.Id())
 	rd := []*schema.ResourceData{d}
@@ -138,12 +126,12 @@ func resourceWorkflowTemplateImport(d *schema.ResourceData, m interface{}) ([]*s
 		return rd, err
 	}
 
-	workflowTemplate, err := c.GetWorkflowTemplateById(id)
+	workflowTemplate, err := c.GetWorkflowTemplateById(ctx, id)
 	if err != nil {
 		return rd, err
 	}
 
-	docTypes, err := c.GetWorkflowIndexDocumentTypes(workflowTemplate.ID)
+	docTypes, err := c.GetWorkflowIndexDocumentTypes(ctx, workflowTemplate.ID)
 	if err != nil {
 		return rd, err
 	}
@@ -156,6 +144,20 @@ func resourceWorkflowTemplateImport(d *schema.ResourceData, m interface{}) ([]*s
 	return rd, err
 }
 
+func workflowTemplateDocumentTypeMembership(ctx context.Context, c client.MayanEdmsClient, workflowTemplateId int) membership {
+	return membership{
+		attribute: "document_types",
+		member:    "document type",
+		owner:     "workflow template",
+		add: func(documentTypeId interface{}) error {
+			return c.AddWorkflowIndexDocumentType(ctx, workflowTemplateId, documentTypeId.(int))
+		},
+		remove: func(documentTypeId interface{}) error {
+			return c.RemoveWorkflowIndexDocumentType(ctx, workflowTemplateId, documentTypeId.(int))
+		},
+	}
+}
+
 func workflowTemplateToData(workflowTemplate *client.WorkflowTemplate, d *schema.ResourceData) error {
 	d.SetId(fmt.Sprintf("%v", workflowTemplate.ID))
 	if err := d.Set("label", workflowTemplate.Label); err != nil {
@@ -1,22 +1,29 @@
 package provider
 
 import (
+	"context"
 	"fmt"
-	"strconv"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/rfleming71/terraform-provider-mayan-edms/client"
 )
 
 func resourceWorkflowTemplateState() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceWorkflowTemplateStateCreate,
-		Read:   resourceWorkflowTemplateStateRead,
-		Update: resourceWorkflowTemplateStateUpdate,
-		Delete: resourceWorkflowTemplateStateDelete,
+		CreateContext: resourceWorkflowTemplateStateCreate,
+		ReadContext:   resourceWorkflowTemplateStateRead,
+		UpdateContext: resourceWorkflowTemplateStateUpdate,
+		DeleteContext: resourceWorkflowTemplateStateDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceWorkflowTemplateStateImport,
+			StateContext: resourceWorkflowTemplateStateImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -46,69 +53,76 @@ func resourceWorkflowTemplateState() *schema.Resource {
 	}
 }
 
-func resourceWorkflowTemplateStateCreate(d *schema.ResourceData, m interface{}) error {
+func resourceWorkflowTemplateStateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	workflowTemplateId, newWorkflowTemplateState := dataToWorkflowTemplateState(d)
 
-	workflowTemplateState, err := c.CreateWorkflowTemplateState(workflowTemplateId, *newWorkflowTemplateState)
+	workflowTemplateState, err := c.CreateWorkflowTemplateState(ctx, workflowTemplateId, *newWorkflowTemplateState)
 
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(d, "Unable to create workflow template state", err)
 	}
 
 	d.SetId(fmt.Sprintf("%v-%v", workflowTemplateId, workflowTemplateState.ID))
 
-	return resourceWorkflowTemplateStateRead(d, m)
+	return resourceWorkflowTemplateStateRead(ctx, d, m)
 }
 
-func resourceWorkflowTemplateStateRead(d *schema.ResourceData, m interface{}) error {
+func resourceWorkflowTemplateStateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	workflowTemplateId, stateId, err := getIdInformation(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	source, err := c.GetWorkflowTemplateState(workflowTemplateId, stateId)
+	source, err := c.GetWorkflowTemplateState(ctx, workflowTemplateId, stateId)
 	if err != nil {
-		return err
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Workflow template state not found, removing from state", map[string]interface{}{
+				"id": d.Id(),
+			})
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
 	}
 
-	return workflowTemplateStateToData(workflowTemplateId, source, d)
+	return diag.FromErr(workflowTemplateStateToData(workflowTemplateId, source, d))
 }
 
-func resourceWorkflowTemplateStateUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceWorkflowTemplateStateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	_, workflowTemplateState := dataToWorkflowTemplateState(d)
 	workflowTemplateId, stateId, err := getIdInformation(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	workflowTemplateState.ID = stateId
 
-	_, err = c.UpdateWorkflowTemplateState(workflowTemplateId, *workflowTemplateState)
+	_, err = c.UpdateWorkflowTemplateState(ctx, workflowTemplateId, *workflowTemplateState)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(d, "Unable to update workflow template state", err)
 	}
 
-	return resourceWorkflowTemplateStateRead(d, m)
+	return resourceWorkflowTemplateStateRead(ctx, d, m)
 }
 
-func resourceWorkflowTemplateStateDelete(d *schema.ResourceData, m interface{}) error {
+func resourceWorkflowTemplateStateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	workflowTemplateId, stateId, err := getIdInformation(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = c.RemoveWorkflowTemplateState(workflowTemplateId, stateId)
+	err = c.RemoveWorkflowTemplateState(ctx, workflowTemplateId, stateId)
 	if err == nil {
 		d.SetId("")
 	}
 
-	return err
+	return diag.FromErr(err)
 }
 
-func resourceWorkflowTemplateStateImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceWorkflowTemplateStateImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	c := m.(client.MayanEdmsClient)
 	workflowTemplateId, stateId, err := getIdInformation(d)
 	rd := []*schema.ResourceData{d}
@@ -116,7 +130,7 @@ func resourceWorkflowTemplateStateImport(d *schema.ResourceData, m interface{})
 		return rd, err
 	}
 
-	workflowTemplateState, err := c.GetWorkflowTemplateState(workflowTemplateId, stateId)
+	workflowTemplateState, err := c.GetWorkflowTemplateState(ctx, workflowTemplateId, stateId)
 	if err != nil {
 		return rd, err
 	}
@@ -157,23 +171,3 @@ func dataToWorkflowTemplateState(d *schema.ResourceData) (int, *client.WorkflowT
 
 	return d.Get("workflow_template").(int), &newDocType
 }
-
-func getIdInformation(d *schema.ResourceData) (int, int, error) {
-	return breakCompositeId(d.Id())
-}
-
-func breakCompositeId(id string) (int, int, error) {
-	ids := strings.Split(id, "-")
-	part1, err := strconv.Atoi(ids[0])
-	if err != nil {
-		return 0, 0, err
-	}
-
-	part2, err := strconv.Atoi(ids[1])
-	if err != nil {
-		return 0, 0, err
-	}
-
-	return part1, part2, nil
-
-}
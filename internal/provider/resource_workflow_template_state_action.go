@@ -0,0 +1,490 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rfleming71/terraform-provider-mayan-edms/client"
+)
+
+var workflowStateActionWhenMapping = map[string]int{
+	"on_entry": client.WorkflowStateActionOnEntry,
+	"on_exit":  client.WorkflowStateActionOnExit,
+}
+
+// workflowStateActionHelper is a typed block for a common action backend,
+// so the backend path and the layout of its action data don't have to be
+// written by hand.
+type workflowStateActionHelper struct {
+	attribute   string
+	description string
+	actionPath  string
+	schema      map[string]*schema.Schema
+	toData      func(block map[string]interface{}) map[string]interface{}
+	fromData    func(data map[string]interface{}) map[string]interface{}
+}
+
+var workflowStateActionHelpers = []workflowStateActionHelper{
+	{
+		attribute:   "attach_tag",
+		description: "Attach tags to the document.",
+		actionPath:  "mayan.apps.tags.workflow_actions.AttachTagAction",
+		schema: map[string]*schema.Schema{
+			"tags": {
+				Description: "Ids of the tags to attach.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+		},
+		toData: func(block map[string]interface{}) map[string]interface{} {
+			return map[string]interface{}{"tags": block["tags"].(*schema.Set).List()}
+		},
+		fromData: func(data map[string]interface{}) map[string]interface{} {
+			return map[string]interface{}{"tags": actionDataInts(data["tags"])}
+		},
+	},
+	{
+		attribute:   "set_metadata",
+		description: "Set the value of a metadata of the document.",
+		actionPath:  "mayan.apps.metadata.workflow_actions.DocumentMetadataEditAction",
+		schema: map[string]*schema.Schema{
+			"metadata_type": {
+				Description: "Id of the metadata type to set.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+			"value": {
+				Description: "Template used to compute the value of the metadata.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+		},
+		toData: func(block map[string]interface{}) map[string]interface{} {
+			return map[string]interface{}{
+				"metadata_type": block["metadata_type"],
+				"value":         block["value"],
+			}
+		},
+		fromData: func(data map[string]interface{}) map[string]interface{} {
+			return map[string]interface{}{
+				"metadata_type": actionDataInt(data["metadata_type"]),
+				"value":         actionDataString(data["value"]),
+			}
+		},
+	},
+	{
+		attribute:   "send_email",
+		description: "Send an email through a mailing profile.",
+		actionPath:  "mayan.apps.mailer.workflow_actions.EmailAction",
+		schema: map[string]*schema.Schema{
+			"mailing_profile": {
+				Description: "Id of the mailing profile used to send the email.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+			"recipient": {
+				Description: "Email address of the recipients, separated by commas or semicolons.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"cc": {
+				Description: "Email address of the carbon copy recipients.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+			},
+			"bcc": {
+				Description: "Email address of the blind carbon copy recipients.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+			},
+			"reply_to": {
+				Description: "Address to which replies are sent.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+			},
+			"subject": {
+				Description: "Subject of the email. Can be a template.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+			},
+			"body": {
+				Description: "Body of the email. Can be a template.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+			},
+			"attachment": {
+				Description: "Whether the document is attached to the email.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+		},
+		toData: func(block map[string]interface{}) map[string]interface{} {
+			data := map[string]interface{}{}
+			for key, value := range block {
+				data[key] = value
+			}
+			return data
+		},
+		fromData: func(data map[string]interface{}) map[string]interface{} {
+			attachment, _ := data["attachment"].(bool)
+			return map[string]interface{}{
+				"mailing_profile": actionDataInt(data["mailing_profile"]),
+				"recipient":       actionDataString(data["recipient"]),
+				"cc":              actionDataString(data["cc"]),
+				"bcc":             actionDataString(data["bcc"]),
+				"reply_to":        actionDataString(data["reply_to"]),
+				"subject":         actionDataString(data["subject"]),
+				"body":            actionDataString(data["body"]),
+				"attachment":      attachment,
+			}
+		},
+	},
+	{
+		attribute:   "launch_workflow",
+		description: "Launch other workflows on the document.",
+		actionPath:  "mayan.apps.document_states.workflow_actions.DocumentWorkflowLaunchAction",
+		schema: map[string]*schema.Schema{
+			"workflows": {
+				Description: "Ids of the workflow templates to launch.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+		},
+		toData: func(block map[string]interface{}) map[string]interface{} {
+			return map[string]interface{}{"workflows": block["workflows"].(*schema.Set).List()}
+		},
+		fromData: func(data map[string]interface{}) map[string]interface{} {
+			return map[string]interface{}{"workflows": actionDataInts(data["workflows"])}
+		},
+	},
+}
+
+func resourceWorkflowTemplateStateAction() *schema.Resource {
+	exactlyOneOf := []string{"action_path"}
+	for _, helper := range workflowStateActionHelpers {
+		exactlyOneOf = append(exactlyOneOf, helper.attribute)
+	}
+
+	s := map[string]*schema.Schema{
+		"label": {
+			Description: "A simple identifier for this action.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		"when": {
+			Description:  "At which moment of the state this action will execute, either `on_entry` or `on_exit`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "on_entry",
+			ValidateFunc: validation.StringInSlice([]string{"on_entry", "on_exit"}, false),
+		},
+		"action_path": {
+			Description:  "Python path of the action backend. Computed when one of the typed action blocks is used.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ExactlyOneOf: exactlyOneOf,
+		},
+		"action_data": {
+			Description:      "JSON object holding the settings of the action backend. Computed when one of the typed action blocks is used.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ConflictsWith:    exactlyOneOf[1:],
+			ValidateFunc:     validateJsonObject,
+			DiffSuppressFunc: structure.SuppressJsonDiff,
+		},
+		"state": {
+			Description: "Id of the `mayanedms_workflow_template_state` this action belongs to.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+	}
+
+	for _, helper := range workflowStateActionHelpers {
+		s[helper.attribute] = &schema.Schema{
+			Description:  helper.description,
+			Type:         schema.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: exactlyOneOf,
+			Elem: &schema.Resource{
+				Schema: helper.schema,
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceWorkflowTemplateStateActionCreate,
+		ReadContext:   resourceWorkflowTemplateStateActionRead,
+		UpdateContext: resourceWorkflowTemplateStateActionUpdate,
+		DeleteContext: resourceWorkflowTemplateStateActionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceWorkflowTemplateStateActionImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: s,
+	}
+}
+
+func resourceWorkflowTemplateStateActionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	workflowTemplateId, stateId, newAction, err := dataToWorkflowStateAction(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	action, err := c.CreateWorkflowTemplateStateAction(ctx, workflowTemplateId, stateId, *newAction)
+
+	if err != nil {
+		return apiErrorDiagnostics(d, "Unable to create workflow template state action", err)
+	}
+
+	d.SetId(fmt.Sprintf("%v-%v-%v", workflowTemplateId, stateId, action.ID))
+
+	return resourceWorkflowTemplateStateActionRead(ctx, d, m)
+}
+
+func resourceWorkflowTemplateStateActionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	ids, err := breakCompositeIds(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	action, err := c.GetWorkflowTemplateStateAction(ctx, ids[0], ids[1], ids[2])
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Workflow template state action not found, removing from state", map[string]interface{}{
+				"id": d.Id(),
+			})
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(workflowStateActionToData(ids[0], ids[1], action, d))
+}
+
+func resourceWorkflowTemplateStateActionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	workflowTemplateId, stateId, action, err := dataToWorkflowStateAction(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = c.UpdateWorkflowTemplateStateAction(ctx, workflowTemplateId, stateId, *action)
+	if err != nil {
+		return apiErrorDiagnostics(d, "Unable to update workflow template state action", err)
+	}
+
+	return resourceWorkflowTemplateStateActionRead(ctx, d, m)
+}
+
+func resourceWorkflowTemplateStateActionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	ids, err := breakCompositeIds(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = c.RemoveWorkflowTemplateStateAction(ctx, ids[0], ids[1], ids[2])
+	if err == nil {
+		d.SetId("")
+	}
+
+	return diag.FromErr(err)
+}
+
+func resourceWorkflowTemplateStateActionImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(client.MayanEdmsClient)
+	ids, err := breakCompositeIds(d.Id(), 3)
+	rd := []*schema.ResourceData{d}
+	if err != nil {
+		return rd, err
+	}
+
+	action, err := c.GetWorkflowTemplateStateAction(ctx, ids[0], ids[1], ids[2])
+	if err != nil {
+		return rd, err
+	}
+
+	err = workflowStateActionToData(ids[0], ids[1], action, d)
+	return rd, err
+}
+
+func workflowStateActionToData(workflowTemplateId int, stateId int, action *client.WorkflowStateAction, d *schema.ResourceData) error {
+	d.SetId(fmt.Sprintf("%v-%v-%v", workflowTemplateId, stateId, action.ID))
+	if err := d.Set("label", action.Label); err != nil {
+		return err
+	}
+
+	if err := d.Set("enabled", action.Enabled); err != nil {
+		return err
+	}
+
+	for when, value := range workflowStateActionWhenMapping {
+		if value == action.When {
+			if err := d.Set("when", when); err != nil {
+				return err
+			}
+		}
+	}
+
+	// A typed block is only populated when it is already in use, or when
+	// nothing is known yet such as during an import, so a configuration that
+	// uses action_path for a backend with a typed block doesn't drift.
+	rawActionPath := d.Get("action_path").(string)
+	for _, helper := range workflowStateActionHelpers {
+		var block []interface{}
+		inUse := len(d.Get(helper.attribute).([]interface{})) > 0
+		if helper.actionPath == action.ActionPath && (inUse || rawActionPath == "") {
+			block = []interface{}{helper.fromData(action.ActionData)}
+		}
+		if err := d.Set(helper.attribute, block); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("action_path", action.ActionPath); err != nil {
+		return err
+	}
+
+	actionData, err := json.Marshal(action.ActionData)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("action_data", string(actionData)); err != nil {
+		return err
+	}
+
+	if err := d.Set("state", fmt.Sprintf("%v-%v", workflowTemplateId, stateId)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func dataToWorkflowStateAction(d *schema.ResourceData) (int, int, *client.WorkflowStateAction, error) {
+	var id int
+	if d.Id() != "" {
+		ids, err := breakCompositeIds(d.Id(), 3)
+		if err != nil {
+			return 0, 0, nil, err
+		}
+		id = ids[2]
+	}
+
+	newAction := client.WorkflowStateAction{
+		ID:      id,
+		Label:   d.Get("label").(string),
+		Enabled: d.Get("enabled").(bool),
+		When:    workflowStateActionWhenMapping[d.Get("when").(string)],
+	}
+
+	helperUsed := false
+	for _, helper := range workflowStateActionHelpers {
+		block := d.Get(helper.attribute).([]interface{})
+		if len(block) == 0 || block[0] == nil {
+			continue
+		}
+
+		helperUsed = true
+		newAction.ActionPath = helper.actionPath
+		newAction.ActionData = helper.toData(block[0].(map[string]interface{}))
+	}
+
+	if !helperUsed {
+		newAction.ActionPath = d.Get("action_path").(string)
+		newAction.ActionData = map[string]interface{}{}
+		if actionData := d.Get("action_data").(string); actionData != "" {
+			if err := json.Unmarshal([]byte(actionData), &newAction.ActionData); err != nil {
+				return 0, 0, nil, fmt.Errorf("invalid action_data: %v", err)
+			}
+		}
+	}
+
+	workflowTemplateId, stateId, err := breakCompositeId(d.Get("state").(string))
+	if err != nil {
+		return 0, 0, nil, fmt.Errorf("invalid state: %v", err)
+	}
+
+	return workflowTemplateId, stateId, &newAction, nil
+}
+
+// validateJsonObject accepts a string holding a JSON object.
+func validateJsonObject(i interface{}, k string) ([]string, []error) {
+	value, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	var object map[string]interface{}
+	if err := json.Unmarshal([]byte(value), &object); err != nil {
+		return nil, []error{fmt.Errorf("%q must be a JSON object: %v", k, err)}
+	}
+
+	return nil, nil
+}
+
+// actionDataInt reads an id from action data, where the web interface stores
+// ids as strings and the API as numbers.
+func actionDataInt(value interface{}) int {
+	switch v := value.(type) {
+	case float64:
+		return int(v)
+	case string:
+		i, _ := strconv.Atoi(v)
+		return i
+	}
+
+	return 0
+}
+
+func actionDataInts(value interface{}) []int {
+	values, _ := value.([]interface{})
+	ints := make([]int, 0, len(values))
+	for _, v := range values {
+		ints = append(ints, actionDataInt(v))
+	}
+
+	return ints
+}
+
+func actionDataString(value interface{}) string {
+	s, _ := value.(string)
+	return s
+}
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rfleming71/terraform-provider-mayan-edms/client"
+)
+
+func TestWorkflowStateActionHelpers(t *testing.T) {
+	cases := []struct {
+		attribute  string
+		block      map[string]interface{}
+		actionPath string
+		actionData string
+	}{
+		{
+			attribute:  "attach_tag",
+			block:      map[string]interface{}{"tags": []interface{}{4}},
+			actionPath: "mayan.apps.tags.workflow_actions.AttachTagAction",
+			actionData: `{"tags": [4]}`,
+		},
+		{
+			attribute:  "set_metadata",
+			block:      map[string]interface{}{"metadata_type": 2, "value": "{{ document.label }}"},
+			actionPath: "mayan.apps.metadata.workflow_actions.DocumentMetadataEditAction",
+			actionData: `{"metadata_type": 2, "value": "{{ document.label }}"}`,
+		},
+		{
+			attribute: "send_email",
+			block: map[string]interface{}{
+				"mailing_profile": 1,
+				"recipient":       "finance@example.com",
+				"subject":         "New invoice",
+				"attachment":      true,
+			},
+			actionPath: "mayan.apps.mailer.workflow_actions.EmailAction",
+			actionData: `{"mailing_profile": 1, "recipient": "finance@example.com", "cc": "", "bcc": "", "reply_to": "", "subject": "New invoice", "body": "", "attachment": true}`,
+		},
+		{
+			attribute:  "launch_workflow",
+			block:      map[string]interface{}{"workflows": []interface{}{9}},
+			actionPath: "mayan.apps.document_states.workflow_actions.DocumentWorkflowLaunchAction",
+			actionData: `{"workflows": [9]}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.attribute, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceWorkflowTemplateStateAction().Schema, map[string]interface{}{
+				"label":      "Action",
+				"state":      "1-2",
+				tc.attribute: []interface{}{tc.block},
+			})
+
+			workflowTemplateId, stateId, action, err := dataToWorkflowStateAction(d)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if workflowTemplateId != 1 || stateId != 2 {
+				t.Errorf("expected state 1-2, got %v-%v", workflowTemplateId, stateId)
+			}
+			if action.ActionPath != tc.actionPath {
+				t.Errorf("expected action path %v, got %v", tc.actionPath, action.ActionPath)
+			}
+
+			// Send the action to the API and read it back as the API returns it.
+			encoded, err := json.Marshal(action)
+			if err != nil {
+				t.Fatalf("unable to encode action: %v", err)
+			}
+			var sent client.WorkflowStateAction
+			if err := json.Unmarshal(encoded, &sent); err != nil {
+				t.Fatalf("unable to decode %s: %v", encoded, err)
+			}
+
+			var expected map[string]interface{}
+			if err := json.Unmarshal([]byte(tc.actionData), &expected); err != nil {
+				t.Fatalf("invalid expected action data: %v", err)
+			}
+			if !reflect.DeepEqual(sent.ActionData, expected) {
+				t.Errorf("expected action data %v, got %v", expected, sent.ActionData)
+			}
+
+			// Reading the action back into an empty resource, as an import does,
+			// fills in the typed block.
+			sent.ID = 3
+			imported := schema.TestResourceDataRaw(t, resourceWorkflowTemplateStateAction().Schema, map[string]interface{}{})
+			if err := workflowStateActionToData(1, 2, &sent, imported); err != nil {
+				t.Fatalf("unable to read action: %v", err)
+			}
+			if imported.Id() != "1-2-3" {
+				t.Errorf("expected id 1-2-3, got %v", imported.Id())
+			}
+			if imported.Get("action_path") != tc.actionPath {
+				t.Errorf("expected action path %v, got %v", tc.actionPath, imported.Get("action_path"))
+			}
+
+			_, _, reread, err := dataToWorkflowStateAction(imported)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if reread.ActionPath != tc.actionPath {
+				t.Errorf("expected the typed block to be read back, got action path %v", reread.ActionPath)
+			}
+			rereadData, _ := json.Marshal(reread.ActionData)
+			sentData, _ := json.Marshal(action.ActionData)
+			if string(rereadData) != string(sentData) {
+				t.Errorf("expected action data %s after reading back, got %s", sentData, rereadData)
+			}
+		})
+	}
+}
+
+func TestWorkflowStateActionRawActionData(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceWorkflowTemplateStateAction().Schema, map[string]interface{}{
+		"label":       "Action",
+		"state":       "1-2",
+		"action_path": "mayan.apps.tags.workflow_actions.AttachTagAction",
+		"action_data": `{"tags": [4]}`,
+	})
+
+	_, _, action, err := dataToWorkflowStateAction(d)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(action.ActionData, map[string]interface{}{"tags": []interface{}{float64(4)}}) {
+		t.Errorf("unexpected action data %v", action.ActionData)
+	}
+
+	// A backend with a typed block configured through action_path keeps
+	// using action_path, so the configuration doesn't drift.
+	action.ID = 3
+	if err := workflowStateActionToData(1, 2, action, d); err != nil {
+		t.Fatalf("unable to read action: %v", err)
+	}
+	if blocks := d.Get("attach_tag").([]interface{}); len(blocks) != 0 {
+		t.Errorf("expected attach_tag to stay empty, got %v", blocks)
+	}
+	if d.Get("action_data") != `{"tags":[4]}` {
+		t.Errorf("unexpected action_data %v", d.Get("action_data"))
+	}
+}
@@ -0,0 +1,243 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rfleming71/terraform-provider-mayan-edms/client"
+)
+
+func resourceWorkflowTemplateStateEscalation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceWorkflowTemplateStateEscalationCreate,
+		ReadContext:   resourceWorkflowTemplateStateEscalationRead,
+		UpdateContext: resourceWorkflowTemplateStateEscalationUpdate,
+		DeleteContext: resourceWorkflowTemplateStateEscalationDelete,
+		CustomizeDiff: resourceWorkflowTemplateStateEscalationCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceWorkflowTemplateStateEscalationImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"state": {
+				Description: "Id of the `mayanedms_workflow_template_state` documents escalate from.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"transition": {
+				Description: "Id of the `mayanedms_workflow_template_transition` executed when the escalation fires. Must leave `state`.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"amount": {
+				Description:  "Number of `unit` a document must stay in the state before the transition is executed.",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"unit": {
+				Description:  "Unit of `amount`, one of `minutes`, `hours`, `days` or `weeks`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "days",
+				ValidateFunc: validation.StringInSlice([]string{"minutes", "hours", "days", "weeks"}, false),
+			},
+			"priority": {
+				Description: "Escalations with a lower priority are checked first when a state has several.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"condition": {
+				Description: "The condition that will determine if this escalation is executed or not.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+			},
+			"comment": {
+				Description: "Comment added to the workflow log when the escalation executes the transition.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+			},
+		},
+	}
+}
+
+func resourceWorkflowTemplateStateEscalationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	workflowTemplateId, stateId, newEscalation, err := dataToWorkflowStateEscalation(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	escalation, err := c.CreateWorkflowTemplateStateEscalation(ctx, workflowTemplateId, stateId, *newEscalation)
+
+	if err != nil {
+		return apiErrorDiagnostics(d, "Unable to create workflow template state escalation", err)
+	}
+
+	d.SetId(fmt.Sprintf("%v-%v-%v", workflowTemplateId, stateId, escalation.ID))
+
+	return resourceWorkflowTemplateStateEscalationRead(ctx, d, m)
+}
+
+func resourceWorkflowTemplateStateEscalationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	ids, err := breakCompositeIds(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	escalation, err := c.GetWorkflowTemplateStateEscalation(ctx, ids[0], ids[1], ids[2])
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Workflow template state escalation not found, removing from state", map[string]interface{}{
+				"id": d.Id(),
+			})
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(workflowStateEscalationToData(ids[0], ids[1], escalation, d))
+}
+
+func resourceWorkflowTemplateStateEscalationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	workflowTemplateId, stateId, escalation, err := dataToWorkflowStateEscalation(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = c.UpdateWorkflowTemplateStateEscalation(ctx, workflowTemplateId, stateId, *escalation)
+	if err != nil {
+		return apiErrorDiagnostics(d, "Unable to update workflow template state escalation", err)
+	}
+
+	return resourceWorkflowTemplateStateEscalationRead(ctx, d, m)
+}
+
+func resourceWorkflowTemplateStateEscalationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	ids, err := breakCompositeIds(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = c.RemoveWorkflowTemplateStateEscalation(ctx, ids[0], ids[1], ids[2])
+	if err == nil {
+		d.SetId("")
+	}
+
+	return diag.FromErr(err)
+}
+
+func resourceWorkflowTemplateStateEscalationImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(client.MayanEdmsClient)
+	ids, err := breakCompositeIds(d.Id(), 3)
+	rd := []*schema.ResourceData{d}
+	if err != nil {
+		return rd, err
+	}
+
+	escalation, err := c.GetWorkflowTemplateStateEscalation(ctx, ids[0], ids[1], ids[2])
+	if err != nil {
+		return rd, err
+	}
+
+	err = workflowStateEscalationToData(ids[0], ids[1], escalation, d)
+	return rd, err
+}
+
+func workflowStateEscalationToData(workflowTemplateId int, stateId int, escalation *client.WorkflowStateEscalation, d *schema.ResourceData) error {
+	d.SetId(fmt.Sprintf("%v-%v-%v", workflowTemplateId, stateId, escalation.ID))
+	if err := d.Set("state", fmt.Sprintf("%v-%v", workflowTemplateId, stateId)); err != nil {
+		return err
+	}
+
+	if err := d.Set("transition", fmt.Sprintf("%v-%v", workflowTemplateId, escalation.TransitionID)); err != nil {
+		return err
+	}
+
+	if err := d.Set("amount", escalation.Amount); err != nil {
+		return err
+	}
+
+	if err := d.Set("unit", escalation.Unit); err != nil {
+		return err
+	}
+
+	if err := d.Set("priority", escalation.Priority); err != nil {
+		return err
+	}
+
+	if err := d.Set("enabled", escalation.Enabled); err != nil {
+		return err
+	}
+
+	if err := d.Set("condition", escalation.Condition); err != nil {
+		return err
+	}
+
+	if err := d.Set("comment", escalation.Comment); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func dataToWorkflowStateEscalation(d *schema.ResourceData) (int, int, *client.WorkflowStateEscalation, error) {
+	var id int
+	if d.Id() != "" {
+		ids, err := breakCompositeIds(d.Id(), 3)
+		if err != nil {
+			return 0, 0, nil, err
+		}
+		id = ids[2]
+	}
+
+	workflowTemplateId, stateId, err := breakCompositeId(d.Get("state").(string))
+	if err != nil {
+		return 0, 0, nil, fmt.Errorf("invalid state: %v", err)
+	}
+
+	transitionWorkflowTemplateId, transitionId, err := breakCompositeId(d.Get("transition").(string))
+	if err != nil {
+		return 0, 0, nil, fmt.Errorf("invalid transition: %v", err)
+	}
+
+	if transitionWorkflowTemplateId != workflowTemplateId {
+		return 0, 0, nil, fmt.Errorf("transition %v does not belong to the workflow template of state %v", d.Get("transition"), d.Get("state"))
+	}
+
+	newEscalation := client.WorkflowStateEscalation{
+		ID:           id,
+		TransitionID: transitionId,
+		Amount:       d.Get("amount").(int),
+		Unit:         d.Get("unit").(string),
+		Priority:     d.Get("priority").(int),
+		Enabled:      d.Get("enabled").(bool),
+		Condition:    d.Get("condition").(string),
+		Comment:      d.Get("comment").(string),
+	}
+
+	return workflowTemplateId, stateId, &newEscalation, nil
+}
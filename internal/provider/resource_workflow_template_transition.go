@@ -1,20 +1,30 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/rfleming71/terraform-provider-mayan-edms/client"
 )
 
 func resourceWorkflowTemplateTransition() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceWorkflowTemplateTransitionCreate,
-		Read:   resourceWorkflowTemplateTransitionRead,
-		Update: resourceWorkflowTemplateTransitionUpdate,
-		Delete: resourceWorkflowTemplateTransitionDelete,
+		CreateContext: resourceWorkflowTemplateTransitionCreate,
+		ReadContext:   resourceWorkflowTemplateTransitionRead,
+		UpdateContext: resourceWorkflowTemplateTransitionUpdate,
+		DeleteContext: resourceWorkflowTemplateTransitionDelete,
+		CustomizeDiff: resourceWorkflowTemplateTransitionCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			State: resourceWorkflowTemplateTransitionImport,
+			StateContext: resourceWorkflowTemplateTransitionImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -32,10 +42,11 @@ func resourceWorkflowTemplateTransition() *schema.Resource {
 				Required: true,
 			},
 			"condition": {
-				Description: "The condition that will determine if this transition is enabled or not.",
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "",
+				Description:      "The condition that will determine if this transition is enabled or not.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "",
+				ValidateDiagFunc: validateDjangoTemplateString,
 			},
 			"workflow_template": {
 				Description: "Id of the workflow template this transition belongs to.",
@@ -47,69 +58,76 @@ func resourceWorkflowTemplateTransition() *schema.Resource {
 	}
 }
 
-func resourceWorkflowTemplateTransitionCreate(d *schema.ResourceData, m interface{}) error {
+func resourceWorkflowTemplateTransitionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	workflowTemplateId, newWorkflowTemplateTransition := dataToWorkflowTemplateTransition(d)
 
-	workflowTemplateTransition, err := c.CreateWorkflowTemplateTransition(workflowTemplateId, *newWorkflowTemplateTransition)
+	workflowTemplateTransition, err := c.CreateWorkflowTemplateTransition(ctx, workflowTemplateId, *newWorkflowTemplateTransition)
 
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(d, "Unable to create workflow template transition", err)
 	}
 
 	d.SetId(fmt.Sprintf("%v-%v", workflowTemplateId, workflowTemplateTransition.ID))
 
-	return resourceWorkflowTemplateTransitionRead(d, m)
+	return resourceWorkflowTemplateTransitionRead(ctx, d, m)
 }
 
-func resourceWorkflowTemplateTransitionRead(d *schema.ResourceData, m interface{}) error {
+func resourceWorkflowTemplateTransitionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	workflowTemplateId, stateId, err := getIdInformation(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	source, err := c.GetWorkflowTemplateTransition(workflowTemplateId, stateId)
+	source, err := c.GetWorkflowTemplateTransition(ctx, workflowTemplateId, stateId)
 	if err != nil {
-		return err
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Workflow template transition not found, removing from state", map[string]interface{}{
+				"id": d.Id(),
+			})
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
 	}
 
-	return workflowTemplateTransitionToData(workflowTemplateId, source, d)
+	return diag.FromErr(workflowTemplateTransitionToData(workflowTemplateId, source, d))
 }
 
-func resourceWorkflowTemplateTransitionUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceWorkflowTemplateTransitionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	_, workflowTemplateTransition := dataToWorkflowTemplateTransition(d)
 	workflowTemplateId, stateId, err := getIdInformation(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	workflowTemplateTransition.ID = stateId
 
-	_, err = c.UpdateWorkflowTemplateTransition(workflowTemplateId, *workflowTemplateTransition)
+	_, err = c.UpdateWorkflowTemplateTransition(ctx, workflowTemplateId, *workflowTemplateTransition)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(d, "Unable to update workflow template transition", err)
 	}
 
-	return resourceWorkflowTemplateTransitionRead(d, m)
+	return resourceWorkflowTemplateTransitionRead(ctx, d, m)
 }
 
-func resourceWorkflowTemplateTransitionDelete(d *schema.ResourceData, m interface{}) error {
+func resourceWorkflowTemplateTransitionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(client.MayanEdmsClient)
 	workflowTemplateId, stateId, err := getIdInformation(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = c.RemoveWorkflowTemplateTransition(workflowTemplateId, stateId)
+	err = c.RemoveWorkflowTemplateTransition(ctx, workflowTemplateId, stateId)
 	if err == nil {
 		d.SetId("")
 	}
 
-	return err
+	return diag.FromErr(err)
 }
 
-func resourceWorkflowTemplateTransitionImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceWorkflowTemplateTransitionImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	c := m.(client.MayanEdmsClient)
 	workflowTemplateId, stateId, err := getIdInformation(d)
 	rd := []*schema.ResourceData{d}
@@ -117,7 +135,7 @@ func resourceWorkflowTemplateTransitionImport(d *schema.ResourceData, m interfac
 		return rd, err
 	}
 
-	workflowTemplateTransition, err := c.GetWorkflowTemplateTransition(workflowTemplateId, stateId)
+	workflowTemplateTransition, err := c.GetWorkflowTemplateTransition(ctx, workflowTemplateId, stateId)
 	if err != nil {
 		return rd, err
 	}
@@ -0,0 +1,267 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rfleming71/terraform-provider-mayan-edms/client"
+)
+
+var workflowTransitionFieldTypeMapping = map[string]int{
+	"character": client.WorkflowTransitionFieldTypeCharacter,
+	"integer":   client.WorkflowTransitionFieldTypeInteger,
+}
+
+var workflowTransitionFieldWidgetMapping = map[string]int{
+	"textarea": client.WorkflowTransitionFieldWidgetTextArea,
+}
+
+func resourceWorkflowTemplateTransitionField() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceWorkflowTemplateTransitionFieldCreate,
+		ReadContext:   resourceWorkflowTemplateTransitionFieldRead,
+		UpdateContext: resourceWorkflowTemplateTransitionFieldUpdate,
+		DeleteContext: resourceWorkflowTemplateTransitionFieldDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceWorkflowTemplateTransitionFieldImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"transition": {
+				Description: "Id of the `mayanedms_workflow_template_transition` that prompts for this field.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description: "The name that will be used to identify this field in other parts of the workflow system.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"label": {
+				Description: "The field name that will be shown on the user interface.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"field_type": {
+				Description:  "The type of the field, either `character` or `integer`.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"character", "integer"}, false),
+			},
+			"help_text": {
+				Description: "An optional message that will help users better understand the purpose of the field and data to provide.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+			},
+			"required": {
+				Description: "Whether this field needs to be filled out or not to proceed.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"widget": {
+				Description:  "An optional class to change the default presentation of the field. The only value accepted is `textarea`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "",
+				ValidateFunc: validation.StringInSlice([]string{"", "textarea"}, false),
+			},
+			"widget_kwargs": {
+				Description: "A group of keyword arguments to customize the widget. Use YAML format.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+			},
+			"lookup": {
+				Description: "Template that returns a comma separated list of values the user picks from. Turns the field into a choice field.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+			},
+		},
+	}
+}
+
+func resourceWorkflowTemplateTransitionFieldCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	workflowTemplateId, transitionId, newField, err := dataToWorkflowTransitionField(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	field, err := c.CreateWorkflowTemplateTransitionField(ctx, workflowTemplateId, transitionId, *newField)
+
+	if err != nil {
+		return apiErrorDiagnostics(d, "Unable to create workflow template transition field", err)
+	}
+
+	d.SetId(fmt.Sprintf("%v-%v-%v", workflowTemplateId, transitionId, field.ID))
+
+	return resourceWorkflowTemplateTransitionFieldRead(ctx, d, m)
+}
+
+func resourceWorkflowTemplateTransitionFieldRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	ids, err := breakCompositeIds(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	field, err := c.GetWorkflowTemplateTransitionField(ctx, ids[0], ids[1], ids[2])
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Workflow template transition field not found, removing from state", map[string]interface{}{
+				"id": d.Id(),
+			})
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(workflowTransitionFieldToData(ids[0], ids[1], field, d))
+}
+
+func resourceWorkflowTemplateTransitionFieldUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	workflowTemplateId, transitionId, field, err := dataToWorkflowTransitionField(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = c.UpdateWorkflowTemplateTransitionField(ctx, workflowTemplateId, transitionId, *field)
+	if err != nil {
+		return apiErrorDiagnostics(d, "Unable to update workflow template transition field", err)
+	}
+
+	return resourceWorkflowTemplateTransitionFieldRead(ctx, d, m)
+}
+
+func resourceWorkflowTemplateTransitionFieldDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	ids, err := breakCompositeIds(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = c.RemoveWorkflowTemplateTransitionField(ctx, ids[0], ids[1], ids[2])
+	if err == nil {
+		d.SetId("")
+	}
+
+	return diag.FromErr(err)
+}
+
+func resourceWorkflowTemplateTransitionFieldImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(client.MayanEdmsClient)
+	ids, err := breakCompositeIds(d.Id(), 3)
+	rd := []*schema.ResourceData{d}
+	if err != nil {
+		return rd, err
+	}
+
+	field, err := c.GetWorkflowTemplateTransitionField(ctx, ids[0], ids[1], ids[2])
+	if err != nil {
+		return rd, err
+	}
+
+	err = workflowTransitionFieldToData(ids[0], ids[1], field, d)
+	return rd, err
+}
+
+func workflowTransitionFieldToData(workflowTemplateId int, transitionId int, field *client.WorkflowTransitionField, d *schema.ResourceData) error {
+	d.SetId(fmt.Sprintf("%v-%v-%v", workflowTemplateId, transitionId, field.ID))
+	if err := d.Set("transition", fmt.Sprintf("%v-%v", workflowTemplateId, transitionId)); err != nil {
+		return err
+	}
+
+	if err := d.Set("name", field.Name); err != nil {
+		return err
+	}
+
+	if err := d.Set("label", field.Label); err != nil {
+		return err
+	}
+
+	for fieldType, value := range workflowTransitionFieldTypeMapping {
+		if value == field.FieldType {
+			if err := d.Set("field_type", fieldType); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := d.Set("help_text", field.HelpText); err != nil {
+		return err
+	}
+
+	if err := d.Set("required", field.Required); err != nil {
+		return err
+	}
+
+	widget := ""
+	for name, value := range workflowTransitionFieldWidgetMapping {
+		if field.Widget != nil && value == *field.Widget {
+			widget = name
+		}
+	}
+
+	if err := d.Set("widget", widget); err != nil {
+		return err
+	}
+
+	if err := d.Set("widget_kwargs", field.WidgetKwargs); err != nil {
+		return err
+	}
+
+	if err := d.Set("lookup", field.Lookup); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func dataToWorkflowTransitionField(d *schema.ResourceData) (int, int, *client.WorkflowTransitionField, error) {
+	var id int
+	if d.Id() != "" {
+		ids, err := breakCompositeIds(d.Id(), 3)
+		if err != nil {
+			return 0, 0, nil, err
+		}
+		id = ids[2]
+	}
+
+	workflowTemplateId, transitionId, err := breakCompositeId(d.Get("transition").(string))
+	if err != nil {
+		return 0, 0, nil, fmt.Errorf("invalid transition: %v", err)
+	}
+
+	newField := client.WorkflowTransitionField{
+		ID:           id,
+		Name:         d.Get("name").(string),
+		Label:        d.Get("label").(string),
+		FieldType:    workflowTransitionFieldTypeMapping[d.Get("field_type").(string)],
+		HelpText:     d.Get("help_text").(string),
+		Required:     d.Get("required").(bool),
+		WidgetKwargs: d.Get("widget_kwargs").(string),
+		Lookup:       d.Get("lookup").(string),
+	}
+
+	if widget, ok := workflowTransitionFieldWidgetMapping[d.Get("widget").(string)]; ok {
+		newField.Widget = &widget
+	}
+
+	return workflowTemplateId, transitionId, &newField, nil
+}
@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rfleming71/terraform-provider-mayan-edms/client"
+)
+
+func resourceWorkflowTemplateTransitionTrigger() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceWorkflowTemplateTransitionTriggerCreate,
+		ReadContext:   resourceWorkflowTemplateTransitionTriggerRead,
+		DeleteContext: resourceWorkflowTemplateTransitionTriggerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceWorkflowTemplateTransitionTriggerImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"transition": {
+				Description: "Id of the `mayanedms_workflow_template_transition` to execute when the event is committed.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"event_type": {
+				Description: "Name of the event type that triggers the transition, such as `documents.document_create` or `metadata.metadata_document_edit`.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+func resourceWorkflowTemplateTransitionTriggerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	workflowTemplateId, transitionId, newTrigger, err := dataToWorkflowTransitionTrigger(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	trigger, err := c.CreateWorkflowTemplateTransitionTrigger(ctx, workflowTemplateId, transitionId, *newTrigger)
+
+	if err != nil {
+		return apiErrorDiagnostics(d, "Unable to create workflow template transition trigger", err)
+	}
+
+	d.SetId(fmt.Sprintf("%v-%v-%v", workflowTemplateId, transitionId, trigger.ID))
+
+	return resourceWorkflowTemplateTransitionTriggerRead(ctx, d, m)
+}
+
+func resourceWorkflowTemplateTransitionTriggerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	ids, err := breakCompositeIds(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	trigger, err := c.GetWorkflowTemplateTransitionTrigger(ctx, ids[0], ids[1], ids[2])
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Workflow template transition trigger not found, removing from state", map[string]interface{}{
+				"id": d.Id(),
+			})
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(workflowTransitionTriggerToData(ids[0], ids[1], trigger, d))
+}
+
+func resourceWorkflowTemplateTransitionTriggerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(client.MayanEdmsClient)
+	ids, err := breakCompositeIds(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = c.RemoveWorkflowTemplateTransitionTrigger(ctx, ids[0], ids[1], ids[2])
+	if err == nil {
+		d.SetId("")
+	}
+
+	return diag.FromErr(err)
+}
+
+func resourceWorkflowTemplateTransitionTriggerImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(client.MayanEdmsClient)
+	ids, err := breakCompositeIds(d.Id(), 3)
+	rd := []*schema.ResourceData{d}
+	if err != nil {
+		return rd, err
+	}
+
+	trigger, err := c.GetWorkflowTemplateTransitionTrigger(ctx, ids[0], ids[1], ids[2])
+	if err != nil {
+		return rd, err
+	}
+
+	err = workflowTransitionTriggerToData(ids[0], ids[1], trigger, d)
+	return rd, err
+}
+
+func workflowTransitionTriggerToData(workflowTemplateId int, transitionId int, trigger *client.WorkflowTransitionTrigger, d *schema.ResourceData) error {
+	d.SetId(fmt.Sprintf("%v-%v-%v", workflowTemplateId, transitionId, trigger.ID))
+	if err := d.Set("event_type", trigger.EventType); err != nil {
+		return err
+	}
+
+	if err := d.Set("transition", fmt.Sprintf("%v-%v", workflowTemplateId, transitionId)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func dataToWorkflowTransitionTrigger(d *schema.ResourceData) (int, int, *client.WorkflowTransitionTrigger, error) {
+	workflowTemplateId, transitionId, err := breakCompositeId(d.Get("transition").(string))
+	if err != nil {
+		return 0, 0, nil, fmt.Errorf("invalid transition: %v", err)
+	}
+
+	newTrigger := client.WorkflowTransitionTrigger{
+		EventType: d.Get("event_type").(string),
+	}
+
+	return workflowTemplateId, transitionId, &newTrigger, nil
+}
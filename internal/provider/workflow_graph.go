@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rfleming71/terraform-provider-mayan-edms/client"
+)
+
+// validateWorkflowGraph rejects workflows that Mayan EDMS would accept but
+// that leave documents stuck: duplicated labels, transitions between states
+// that are not declared and more than one initial state.
+func validateWorkflowGraph(states []client.WorkflowTemplateState, transitions []workflowTransition) error {
+	declared := map[string]bool{}
+	var initial []string
+	for _, state := range states {
+		if declared[state.Label] {
+			return fmt.Errorf("state %q is declared more than once", state.Label)
+		}
+		declared[state.Label] = true
+
+		if state.Initial {
+			initial = append(initial, state.Label)
+		}
+	}
+
+	if len(initial) > 1 {
+		return fmt.Errorf("only one state can be initial, found %q", initial)
+	}
+
+	labels := map[string]bool{}
+	for _, transition := range transitions {
+		if labels[transition.label] {
+			return fmt.Errorf("transition %q is declared more than once", transition.label)
+		}
+		labels[transition.label] = true
+
+		for _, state := range []string{transition.originState, transition.destinationState} {
+			if !declared[state] {
+				return fmt.Errorf("transition %q refers to state %q, which is not declared", transition.label, state)
+			}
+		}
+	}
+
+	return nil
+}
+
+// workflowGraphWarnings lists the states a document can never reach from the
+// initial state, and the states a document can never leave although they
+// don't complete the workflow.
+func workflowGraphWarnings(states []client.WorkflowTemplateState, transitions []workflowTransition) []string {
+	if len(states) == 0 {
+		return nil
+	}
+
+	outgoing := map[string][]string{}
+	for _, transition := range transitions {
+		outgoing[transition.originState] = append(outgoing[transition.originState], transition.destinationState)
+	}
+
+	var warnings []string
+	reached := map[string]bool{}
+	var queue []string
+	for _, state := range states {
+		if state.Initial {
+			reached[state.Label] = true
+			queue = append(queue, state.Label)
+		}
+	}
+
+	if len(queue) == 0 {
+		warnings = append(warnings, "no state is initial, the workflow cannot be launched")
+	}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, next := range outgoing[current] {
+			if !reached[next] {
+				reached[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	for _, state := range states {
+		if len(reached) > 0 && !reached[state.Label] {
+			warnings = append(warnings, fmt.Sprintf("state %q cannot be reached from the initial state", state.Label))
+		}
+		if len(outgoing[state.Label]) == 0 && state.Completion < 100 {
+			warnings = append(warnings, fmt.Sprintf("state %q has no outgoing transition but is only %v%% complete", state.Label, state.Completion))
+		}
+	}
+
+	return warnings
+}
+
+// workflowTemplateOf returns the workflow template part of an attribute
+// holding a "<workflow_template>-<id>" id, when it is known at plan time.
+func workflowTemplateOf(d *schema.ResourceDiff, attribute string) (int, bool) {
+	if !d.NewValueKnown(attribute) {
+		return 0, false
+	}
+
+	workflowTemplateId, _, err := breakCompositeId(d.Get(attribute).(string))
+	if err != nil {
+		return 0, false
+	}
+
+	return workflowTemplateId, true
+}
+
+// validateSameWorkflowTemplate rejects states and transitions of another
+// workflow template than the one of the first attribute.
+func validateSameWorkflowTemplate(d *schema.ResourceDiff, workflowTemplateId int, attributes ...string) error {
+	for _, attribute := range attributes {
+		other, ok := workflowTemplateOf(d, attribute)
+		if ok && other != workflowTemplateId {
+			return fmt.Errorf("%v %v belongs to workflow template %v, not %v", attribute, d.Get(attribute), other, workflowTemplateId)
+		}
+	}
+
+	return nil
+}
+
+func resourceWorkflowTemplateTransitionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("workflow_template") {
+		return nil
+	}
+
+	return validateSameWorkflowTemplate(d, d.Get("workflow_template").(int), "origin_state", "destination_state")
+}
+
+func resourceWorkflowTemplateStateEscalationCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	workflowTemplateId, ok := workflowTemplateOf(d, "state")
+	if !ok {
+		return nil
+	}
+
+	return validateSameWorkflowTemplate(d, workflowTemplateId, "transition")
+}
@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rfleming71/terraform-provider-mayan-edms/client"
+)
+
+func TestValidateWorkflowGraph(t *testing.T) {
+	cases := []struct {
+		name        string
+		states      []client.WorkflowTemplateState
+		transitions []workflowTransition
+		err         string
+	}{
+		{
+			name: "valid",
+			states: []client.WorkflowTemplateState{
+				{Label: "draft", Initial: true},
+				{Label: "approved", Completion: 100},
+			},
+			transitions: []workflowTransition{
+				{label: "approve", originState: "draft", destinationState: "approved"},
+			},
+		},
+		{
+			name: "no initial state",
+			states: []client.WorkflowTemplateState{
+				{Label: "draft"},
+				{Label: "approved", Completion: 100},
+			},
+		},
+		{
+			name: "more than one initial state",
+			states: []client.WorkflowTemplateState{
+				{Label: "draft", Initial: true},
+				{Label: "received", Initial: true},
+			},
+			err: `only one state can be initial, found ["draft" "received"]`,
+		},
+		{
+			name: "duplicated state",
+			states: []client.WorkflowTemplateState{
+				{Label: "draft", Initial: true},
+				{Label: "draft"},
+			},
+			err: `state "draft" is declared more than once`,
+		},
+		{
+			name: "duplicated transition",
+			states: []client.WorkflowTemplateState{
+				{Label: "draft", Initial: true},
+				{Label: "approved", Completion: 100},
+			},
+			transitions: []workflowTransition{
+				{label: "approve", originState: "draft", destinationState: "approved"},
+				{label: "approve", originState: "approved", destinationState: "draft"},
+			},
+			err: `transition "approve" is declared more than once`,
+		},
+		{
+			name: "transition from undeclared state",
+			states: []client.WorkflowTemplateState{
+				{Label: "approved", Initial: true, Completion: 100},
+			},
+			transitions: []workflowTransition{
+				{label: "approve", originState: "draft", destinationState: "approved"},
+			},
+			err: `transition "approve" refers to state "draft", which is not declared`,
+		},
+		{
+			name: "transition to undeclared state",
+			states: []client.WorkflowTemplateState{
+				{Label: "draft", Initial: true},
+			},
+			transitions: []workflowTransition{
+				{label: "approve", originState: "draft", destinationState: "approved"},
+			},
+			err: `transition "approve" refers to state "approved", which is not declared`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateWorkflowGraph(tc.states, tc.transitions)
+
+			if tc.err == "" && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tc.err != "" && (err == nil || err.Error() != tc.err) {
+				t.Fatalf("expected error %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestWorkflowGraphWarnings(t *testing.T) {
+	cases := []struct {
+		name        string
+		states      []client.WorkflowTemplateState
+		transitions []workflowTransition
+		warnings    []string
+	}{
+		{
+			name: "complete",
+			states: []client.WorkflowTemplateState{
+				{Label: "draft", Initial: true},
+				{Label: "review", Completion: 50},
+				{Label: "approved", Completion: 100},
+			},
+			transitions: []workflowTransition{
+				{label: "submit", originState: "draft", destinationState: "review"},
+				{label: "reject", originState: "review", destinationState: "draft"},
+				{label: "approve", originState: "review", destinationState: "approved"},
+			},
+		},
+		{
+			name: "no states",
+		},
+		{
+			name: "no initial state",
+			states: []client.WorkflowTemplateState{
+				{Label: "draft"},
+				{Label: "approved", Completion: 100},
+			},
+			transitions: []workflowTransition{
+				{label: "approve", originState: "draft", destinationState: "approved"},
+			},
+			warnings: []string{"no state is initial, the workflow cannot be launched"},
+		},
+		{
+			name: "unreachable states",
+			states: []client.WorkflowTemplateState{
+				{Label: "draft", Initial: true},
+				{Label: "approved", Completion: 100},
+				{Label: "archived", Completion: 100},
+			},
+			transitions: []workflowTransition{
+				{label: "approve", originState: "draft", destinationState: "approved"},
+			},
+			warnings: []string{`state "archived" cannot be reached from the initial state`},
+		},
+		{
+			name: "unreachable through an unreachable state",
+			states: []client.WorkflowTemplateState{
+				{Label: "draft", Initial: true, Completion: 100},
+				{Label: "review", Completion: 50},
+				{Label: "approved", Completion: 100},
+			},
+			transitions: []workflowTransition{
+				{label: "approve", originState: "review", destinationState: "approved"},
+			},
+			warnings: []string{
+				`state "review" cannot be reached from the initial state`,
+				`state "approved" cannot be reached from the initial state`,
+			},
+		},
+		{
+			name: "dead end states",
+			states: []client.WorkflowTemplateState{
+				{Label: "draft", Initial: true},
+				{Label: "review", Completion: 50},
+				{Label: "approved", Completion: 100},
+			},
+			transitions: []workflowTransition{
+				{label: "submit", originState: "draft", destinationState: "review"},
+				{label: "approve", originState: "draft", destinationState: "approved"},
+			},
+			warnings: []string{`state "review" has no outgoing transition but is only 50% complete`},
+		},
+		{
+			name: "single initial dead end",
+			states: []client.WorkflowTemplateState{
+				{Label: "draft", Initial: true},
+			},
+			warnings: []string{`state "draft" has no outgoing transition but is only 0% complete`},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			warnings := workflowGraphWarnings(tc.states, tc.transitions)
+
+			if strings.Join(warnings, "\n") != strings.Join(tc.warnings, "\n") {
+				t.Fatalf("expected warnings %q, got %q", tc.warnings, warnings)
+			}
+		})
+	}
+}
+
+func TestWorkflowGraphDiagnostics(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceWorkflow().Schema, map[string]interface{}{
+		"label":         "Invoices",
+		"internal_name": "invoices",
+		"state": []interface{}{
+			map[string]interface{}{"label": "draft", "initial": true},
+			map[string]interface{}{"label": "archived", "completion": 100},
+		},
+	})
+
+	diags := workflowGraphDiagnostics(d)
+
+	expected := []string{
+		`State "draft" has no outgoing transition but is only 0% complete.`,
+		`State "archived" cannot be reached from the initial state.`,
+	}
+	if len(diags) != len(expected) {
+		t.Fatalf("expected %v diagnostics, got %v", len(expected), diags)
+	}
+	for i, d := range diags {
+		if d.Severity != diag.Warning || d.Detail != expected[i] {
+			t.Errorf("diagnostic %v: expected warning %q, got %v %q", i, expected[i], d.Severity, d.Detail)
+		}
+		if !d.AttributePath.Equals(cty.GetAttrPath("state")) {
+			t.Errorf("diagnostic %v: expected the diagnostic to point at state, got %#v", i, d.AttributePath)
+		}
+	}
+}